@@ -0,0 +1,162 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum_test
+
+import (
+	"strconv"
+	"testing"
+
+	"github.com/phelmkamp/valor/enum"
+	"github.com/phelmkamp/valor/optional"
+	"github.com/phelmkamp/valor/tuple/two"
+)
+
+// mapEnum is the previous implementation of enum.Enum, kept for comparison.
+// Each copy carries a map of the allowed values and names are found by scanning it.
+type mapEnum[T comparable] struct {
+	optional.Value[T]
+	members map[T]mapMetadata
+}
+
+type mapMetadata struct {
+	i    int
+	name string
+}
+
+func mapEnumOf[T comparable](pairs ...two.Tuple[string, T]) mapEnum[T] {
+	e := mapEnum[T]{members: make(map[T]mapMetadata)}
+	for i, m := range pairs {
+		e.members[m.V2] = mapMetadata{i: i, name: m.V}
+	}
+	return e
+}
+
+func (e mapEnum[T]) ValueOf(v T) mapEnum[T] {
+	_, ok := e.members[v]
+	e.Value = optional.Of(v, ok)
+	return e
+}
+
+func (e mapEnum[T]) Values() []T {
+	s := make([]T, len(e.members))
+	for v, m := range e.members {
+		s[m.i] = v
+	}
+	return s
+}
+
+func (e mapEnum[T]) MarshalText() ([]byte, error) {
+	var v T
+	if !e.Ok(&v) {
+		return nil, nil
+	}
+	return []byte(e.members[v].name), nil
+}
+
+func (e *mapEnum[T]) UnmarshalText(text []byte) error {
+	s := string(text)
+	for v, m := range e.members {
+		if m.name == s {
+			e.Value = optional.OfOk(v)
+			return nil
+		}
+	}
+	e.Value = optional.OfNotOk[T]()
+	return nil
+}
+
+// benchPairs returns n name-value pairs.
+func benchPairs(n int) []two.Tuple[string, int] {
+	pairs := make([]two.Tuple[string, int], n)
+	for i := range pairs {
+		pairs[i] = two.TupleOf("member"+strconv.Itoa(i), i)
+	}
+	return pairs
+}
+
+var benchSizes = []int{4, 64}
+
+func BenchmarkValueOf(b *testing.B) {
+	for _, n := range benchSizes {
+		pairs := benchPairs(n)
+		b.Run("map/"+strconv.Itoa(n), func(b *testing.B) {
+			e := mapEnumOf(pairs...)
+			for i := 0; b.Loop(); i++ {
+				_ = e.ValueOf(i % n)
+			}
+		})
+		b.Run("decl/"+strconv.Itoa(n), func(b *testing.B) {
+			e := enum.Of(pairs...)
+			for i := 0; b.Loop(); i++ {
+				_ = e.ValueOf(i % n)
+			}
+		})
+	}
+}
+
+func BenchmarkValues(b *testing.B) {
+	for _, n := range benchSizes {
+		pairs := benchPairs(n)
+		b.Run("map/"+strconv.Itoa(n), func(b *testing.B) {
+			e := mapEnumOf(pairs...)
+			for b.Loop() {
+				_ = e.Values()
+			}
+		})
+		b.Run("decl/"+strconv.Itoa(n), func(b *testing.B) {
+			e := enum.Of(pairs...)
+			for b.Loop() {
+				_ = e.Values()
+			}
+		})
+		b.Run("all/"+strconv.Itoa(n), func(b *testing.B) {
+			e := enum.Of(pairs...)
+			for b.Loop() {
+				for range e.All() {
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkUnmarshalText(b *testing.B) {
+	for _, n := range benchSizes {
+		pairs := benchPairs(n)
+		texts := make([][]byte, n)
+		for i, p := range pairs {
+			texts[i] = []byte(p.V)
+		}
+		b.Run("map/"+strconv.Itoa(n), func(b *testing.B) {
+			e := mapEnumOf(pairs...)
+			for i := 0; b.Loop(); i++ {
+				_ = e.UnmarshalText(texts[i%n])
+			}
+		})
+		b.Run("decl/"+strconv.Itoa(n), func(b *testing.B) {
+			e := enum.Of(pairs...)
+			for i := 0; b.Loop(); i++ {
+				_ = e.UnmarshalText(texts[i%n])
+			}
+		})
+	}
+}
+
+func BenchmarkMarshalText(b *testing.B) {
+	for _, n := range benchSizes {
+		pairs := benchPairs(n)
+		b.Run("map/"+strconv.Itoa(n), func(b *testing.B) {
+			e := mapEnumOf(pairs...).ValueOf(n - 1)
+			for b.Loop() {
+				_, _ = e.MarshalText()
+			}
+		})
+		b.Run("decl/"+strconv.Itoa(n), func(b *testing.B) {
+			e := enum.Of(pairs...).ValueOf(n - 1)
+			for b.Loop() {
+				_, _ = e.MarshalText()
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum
+
+import "strings"
+
+// decl is the declaration of an Enum: its allowed values, their names and options.
+// It's immutable once initialized and shared by all copies of an Enum,
+// which keeps an Enum compact and comparable.
+// A nil *decl has no allowed values.
+type decl[T comparable] struct {
+	values  []T            // allowed values in declaration order
+	names   []string       // names of values
+	ordinal map[T]int      // position of each value
+	aliases map[string]int // position of each alias
+	strict  bool           // report invalid names and values when decoding
+	fold    bool           // match names case-insensitively
+	json    bool           // encode names instead of values as JSON
+
+	byName map[string]int // position of each name or alias
+	folded map[string]int // position of each case-folded name or alias if fold is true
+}
+
+// newDecl creates a decl of the given names and values.
+// If a value is repeated, its first position takes precedence.
+func newDecl[T comparable](names []string, values []T) *decl[T] {
+	d := &decl[T]{values: values, names: names, ordinal: make(map[T]int, len(values))}
+	for i, v := range values {
+		if _, ok := d.ordinal[v]; !ok {
+			d.ordinal[v] = i
+		}
+	}
+	d.index()
+	return d
+}
+
+// clone returns a shallow copy of d for the purpose of changing its options.
+func (d *decl[T]) clone() *decl[T] {
+	if d == nil {
+		return newDecl[T](nil, nil)
+	}
+	c := *d
+	return &c
+}
+
+// index computes the lookup tables for names and aliases.
+// Names take precedence over aliases and exact matches over case-insensitive ones.
+func (d *decl[T]) index() {
+	d.byName = make(map[string]int, len(d.names)+len(d.aliases))
+	for alias, i := range d.aliases {
+		d.byName[alias] = i
+	}
+	for i := len(d.names) - 1; i >= 0; i-- {
+		d.byName[d.names[i]] = i
+	}
+	d.folded = nil
+	if !d.fold {
+		return
+	}
+	d.folded = make(map[string]int, len(d.byName))
+	for alias, i := range d.aliases {
+		d.folded[strings.ToLower(alias)] = i
+	}
+	for i := len(d.names) - 1; i >= 0; i-- {
+		d.folded[strings.ToLower(d.names[i])] = i
+	}
+}
+
+// same returns whether d and d2 declare the same allowed values,
+// i.e. one is d2 or a copy of d2 with different options.
+func (d *decl[T]) same(d2 *decl[T]) bool {
+	if d == d2 {
+		return true
+	}
+	if d == nil || d2 == nil || len(d.values) != len(d2.values) {
+		return false
+	}
+	return len(d.values) == 0 || &d.values[0] == &d2.values[0]
+}
+
+// len returns the number of allowed values.
+func (d *decl[T]) len() int {
+	if d == nil {
+		return 0
+	}
+	return len(d.values)
+}
+
+// position returns the position of v.
+// Returns false if v is not an allowed value.
+func (d *decl[T]) position(v T) (int, bool) {
+	if d == nil {
+		return 0, false
+	}
+	i, ok := d.ordinal[v]
+	return i, ok
+}
+
+// lookup returns the position of the value with the given name or alias.
+// Returns false if there is no such value.
+func (d *decl[T]) lookup(name string) (int, bool) {
+	if d == nil {
+		return 0, false
+	}
+	if i, ok := d.byName[name]; ok {
+		return i, true
+	}
+	if d.fold {
+		i, ok := d.folded[strings.ToLower(name)]
+		return i, ok
+	}
+	return 0, false
+}
+
+// name returns the name of v.
+// Returns false if v is not an allowed value.
+func (d *decl[T]) name(v T) (string, bool) {
+	i, ok := d.position(v)
+	if !ok {
+		return "", false
+	}
+	return d.names[i], true
+}
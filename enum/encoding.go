@@ -0,0 +1,102 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"database/sql"
+	"encoding/xml"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+// MarshalXML encodes e as an XML element.
+// Marshals the name of the current member if ok,
+// omits the element if not ok or not a member of the allowed values.
+func (e Enum[T]) MarshalXML(enc *xml.Encoder, start xml.StartElement) error {
+	var name string
+	if !e.name().Ok(&name) {
+		return nil
+	}
+	return enc.EncodeElement(name, start)
+}
+
+// UnmarshalXML decodes an XML element into e.
+// The character data of the element is decoded as specified by UnmarshalText.
+func (e *Enum[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var s string
+	if err := d.DecodeElement(&s, &start); err != nil {
+		return err
+	}
+	return e.UnmarshalText([]byte(s))
+}
+
+// MarshalXMLAttr encodes e as an XML attribute.
+// Marshals the name of the current member if ok,
+// omits the attribute if not ok or not a member of the allowed values.
+func (e Enum[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	var text string
+	if !e.name().Ok(&text) {
+		return xml.Attr{}, nil
+	}
+	return xml.Attr{Name: name, Value: text}, nil
+}
+
+// UnmarshalXMLAttr decodes an XML attribute into e.
+// The value of the attribute is decoded as specified by UnmarshalText.
+func (e *Enum[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	return e.UnmarshalText([]byte(attr.Value))
+}
+
+// GobEncode encodes e as gob.
+// Encodes the name of the current member if ok, nothing if not ok.
+// This overrides the encoding of the underlying value by the embedded optional.Value.
+func (e Enum[T]) GobEncode() ([]byte, error) {
+	return e.MarshalText()
+}
+
+// GobDecode decodes data into e.
+// data is decoded as a name as specified by UnmarshalText.
+func (e *Enum[T]) GobDecode(data []byte) error {
+	return e.UnmarshalText(data)
+}
+
+// Scan implements the sql.Scanner interface.
+// A string or []byte src is decoded as a name as specified by UnmarshalText.
+// If it's not a valid name, it's converted to T instead, since drivers may return numbers as text.
+// Any other src is converted to T according to the rules of sql.Rows.Scan
+// and e will be ok if the result is a member of the allowed values.
+// Sets e to not-ok if src is nil (i.e. NULL).
+//
+// If e has no allowed values, they're taken from the Enum registered for T (see Register).
+// If e is Strict, returns an error for src that is neither a valid name nor a member.
+func (e *Enum[T]) Scan(src any) error {
+	e.resolve()
+	var text string
+	switch src := src.(type) {
+	case string:
+		text = src
+	case []byte:
+		text = string(src)
+	default:
+		// scan into temp first in case of error
+		var temp sql.Null[T]
+		if err := temp.Scan(src); err != nil {
+			return err
+		}
+		if !temp.Valid {
+			e.Value = optional.OfNotOk[T]()
+			return nil
+		}
+		return e.setValue(temp.V)
+	}
+	if _, ok := e.lookup(text); !ok {
+		var temp sql.Null[T]
+		if temp.Scan(text) == nil && e.ValueOf(temp.V).IsOk() {
+			e.Value = optional.OfOk(temp.V)
+			return nil
+		}
+	}
+	return e.UnmarshalText([]byte(text))
+}
@@ -2,7 +2,16 @@ package enum
 
 import (
 	"encoding"
+	"encoding/json"
 	"fmt"
+	"io"
+	"iter"
+	"log/slog"
+	"maps"
+	"slices"
+	"strings"
+
+	"github.com/phelmkamp/valor/internal/format"
 	"github.com/phelmkamp/valor/optional"
 	"github.com/phelmkamp/valor/tuple/two"
 )
@@ -10,14 +19,12 @@ import (
 // Enum is an enumerated type.
 //
 // It wraps an optional.Value that is only ok if it's a member of the allowed values.
+// Enums are comparable: copies of the same Enum are equal if they wrap the same value.
+// Options such as Strict and FoldCase create a copy of the declaration,
+// so Enums with different options are never equal; compare their Value fields instead.
 type Enum[T comparable] struct {
 	optional.Value[T]
-	members map[T]metadata // carry allowed values for validation
-}
-
-type metadata struct {
-	i    int
-	name string
+	decl *decl[T] // carry allowed values for validation
 }
 
 // ComparableText is a constraint that permits
@@ -29,81 +36,285 @@ type ComparableText interface {
 
 // Of creates an Enum of the given name-value pairs.
 func Of[T comparable](pairs ...two.Tuple[string, T]) Enum[T] {
-	e := Enum[T]{members: make(map[T]metadata)}
-	for i, m := range pairs {
-		e.members[m.V2] = metadata{i: i, name: m.V}
+	names, vals := make([]string, len(pairs)), make([]T, len(pairs))
+	for i, p := range pairs {
+		names[i], vals[i] = p.Values()
 	}
-	return e
+	return Enum[T]{decl: newDecl(names, vals)}
 }
 
 // OfString creates an Enum of the given string values.
 func OfString(vals ...string) Enum[string] {
-	e := Enum[string]{members: make(map[string]metadata)}
-	for i, v := range vals {
-		e.members[v] = metadata{i: i, name: v}
-	}
-	return e
+	vals = slices.Clone(vals)
+	return Enum[string]{decl: newDecl(vals, vals)}
 }
 
 // OfText creates an Enum of the given text values.
 // Panics if MarshalText returns an error for one of the values.
 func OfText[T ComparableText](vals ...T) Enum[T] {
-	e := Enum[T]{members: make(map[T]metadata)}
+	names := make([]string, len(vals))
 	for i, v := range vals {
 		text, err := v.MarshalText()
 		if err != nil {
 			panic(err)
 		}
-		e.members[v] = metadata{i: i, name: string(text)}
+		names[i] = string(text)
 	}
-	return e
+	return Enum[T]{decl: newDecl(names, slices.Clone(vals))}
 }
 
 // ValueOf returns an Enum that wraps v if v is a member of the allowed values.
 // Returns a not-ok Enum otherwise.
 func (e Enum[T]) ValueOf(v T) Enum[T] {
-	_, ok := e.members[v]
+	_, ok := e.decl.position(v)
 	e.Value = optional.Of(v, ok)
 	return e
 }
 
 // String returns e formatted as a string.
 func (e Enum[T]) String() string {
-	val := optional.Map(e.Value, func(v T) string {
-		return e.members[v].name
-	})
-	return fmt.Sprint(val)
+	return fmt.Sprint(e)
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %v verb formats the name of the current member like an optional.Value, e.g. Some(hearts) or None.
+// A value that is not a member of the allowed values is formatted as None.
+// The %+v verb adds the underlying value, e.g. Some(hearts=♥).
+// The %#v verb formats e as Go syntax (see GoString).
+// Use Bare to format the name alone.
+func (e Enum[T]) Format(s fmt.State, verb rune) {
+	if format.GoSyntax(s, verb) {
+		io.WriteString(s, e.GoString())
+		return
+	}
+	var v T
+	val := e.name()
+	if val.IsOk() && e.Ok(&v) && verb == 'v' && s.Flag('+') {
+		val = optional.OfOk(fmt.Sprintf("%s=%+v", val.OrZero(), v))
+	}
+	val.Format(s, verb)
+}
+
+// Bare returns a fmt.Formatter that formats the name of the current member alone, e.g. hearts,
+// and <none> if e is not ok or not a member of the allowed values.
+func (e Enum[T]) Bare() fmt.Formatter {
+	return e.name().Bare()
 }
 
-// Values returns the allowed values.
+// GoString implements the fmt.GoStringer interface.
+// Returns the constructor call that creates e, e.g. enum.Of[int](two.TupleOf[string, int]("one", 1)).ValueOf(1).
+func (e Enum[T]) GoString() string {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "enum.Of[%s](", format.TypeName[T]())
+	for i, v := range e.Values() {
+		if i > 0 {
+			sb.WriteString(", ")
+		}
+		fmt.Fprintf(&sb, "two.TupleOf[string, %s](%q, %#v)", format.TypeName[T](), e.decl.names[i], v)
+	}
+	sb.WriteString(")")
+	if e.decl == nil {
+		return sb.String()
+	}
+	if len(e.decl.aliases) > 0 {
+		sb.WriteString(".WithAliases(")
+		for i, alias := range slices.Sorted(maps.Keys(e.decl.aliases)) {
+			if i > 0 {
+				sb.WriteString(", ")
+			}
+			fmt.Fprintf(&sb, "two.TupleOf[string, %s](%q, %#v)", format.TypeName[T](), alias, e.decl.values[e.decl.aliases[alias]])
+		}
+		sb.WriteString(")")
+	}
+	if e.decl.fold {
+		sb.WriteString(".FoldCase()")
+	}
+	if e.decl.json {
+		sb.WriteString(".JSONNames()")
+	}
+	if e.decl.strict {
+		sb.WriteString(".Strict()")
+	}
+	var v T
+	if e.Ok(&v) {
+		fmt.Fprintf(&sb, ".ValueOf(%#v)", v)
+	}
+	return sb.String()
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Returns the name of the current member if ok.
+// Returns an empty group if not ok or not a member of the allowed values, which causes handlers to omit the attribute.
+func (e Enum[T]) LogValue() slog.Value {
+	var name string
+	if !e.name().Ok(&name) {
+		return slog.GroupValue()
+	}
+	return slog.StringValue(name)
+}
+
+// Values returns the allowed values in declaration order.
+// The returned slice is a copy that may be modified; use All to iterate without allocating.
 func (e Enum[T]) Values() []T {
-	s := make([]T, len(e.members))
-	for v, m := range e.members {
-		s[m.i] = v
+	if e.decl == nil {
+		return []T{}
 	}
-	return s
+	return slices.Clone(e.decl.values)
 }
 
-// MarshalText returns the name of the current member.
-// Returns nil if e is not ok.
-func (e Enum[T]) MarshalText() (text []byte, err error) {
+// Names returns the names of the allowed values in declaration order.
+// The returned slice is a copy that may be modified; use All to iterate without allocating.
+func (e Enum[T]) Names() []string {
+	if e.decl == nil {
+		return []string{}
+	}
+	return slices.Clone(e.decl.names)
+}
+
+// All returns an iterator over the name-value pairs of the allowed values in declaration order.
+func (e Enum[T]) All() iter.Seq2[string, T] {
+	return func(yield func(string, T) bool) {
+		for i := range e.Len() {
+			if !yield(e.decl.names[i], e.decl.values[i]) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of allowed values.
+func (e Enum[T]) Len() int {
+	return e.decl.len()
+}
+
+// NameOf returns the name of v.
+// Returns a not-ok Value if v is not a member of the allowed values.
+func (e Enum[T]) NameOf(v T) optional.Value[string] {
+	return optional.Of(e.decl.name(v))
+}
+
+// name returns the name of the current member.
+// Returns a not-ok Value if e is not ok or its value is not a member of the allowed values,
+// which is possible because the embedded Value can be set directly.
+func (e Enum[T]) name() optional.Value[string] {
+	return optional.FlatMap(e.Value, e.NameOf)
+}
+
+// Ordinal returns the position of the current member in declaration order, starting at 0.
+// Returns a not-ok Value if e is not ok.
+func (e Enum[T]) Ordinal() optional.Value[int] {
 	var v T
 	if !e.Ok(&v) {
+		return optional.OfNotOk[int]()
+	}
+	return optional.Of(e.decl.position(v))
+}
+
+// ValueAt returns an Enum that wraps the member at position i in declaration order.
+// Returns a not-ok Enum if i is out of range.
+func (e Enum[T]) ValueAt(i int) Enum[T] {
+	if i < 0 || i >= e.Len() {
+		e.Value = optional.OfNotOk[T]()
+		return e
+	}
+	e.Value = optional.OfOk(e.decl.values[i])
+	return e
+}
+
+// Next returns an Enum that wraps the member after the current one in declaration order.
+// If wrap is true, the first member follows the last.
+// Returns a not-ok Enum if e is not ok or if e is the last member and wrap is false.
+func (e Enum[T]) Next(wrap bool) Enum[T] {
+	return e.step(1, wrap)
+}
+
+// Prev returns an Enum that wraps the member before the current one in declaration order.
+// If wrap is true, the last member precedes the first.
+// Returns a not-ok Enum if e is not ok or if e is the first member and wrap is false.
+func (e Enum[T]) Prev(wrap bool) Enum[T] {
+	return e.step(-1, wrap)
+}
+
+// step returns an Enum that wraps the member at offset n from the current one.
+func (e Enum[T]) step(n int, wrap bool) Enum[T] {
+	var i int
+	if !e.Ordinal().Ok(&i) {
+		return e
+	}
+	i += n
+	if wrap {
+		i = (i%e.Len() + e.Len()) % e.Len()
+	}
+	return e.ValueAt(i)
+}
+
+// MarshalText returns the name of the current member.
+// Returns nil if e is not ok or not a member of the allowed values.
+func (e Enum[T]) MarshalText() (text []byte, err error) {
+	var name string
+	if !e.name().Ok(&name) {
 		return nil, nil
 	}
-	return []byte(e.members[v].name), nil
+	return []byte(name), nil
 }
 
 // UnmarshalText sets e to wrap the member with the given name.
-// Sets e to not-ok if text is not the name of a valid member.
+// Sets e to not-ok if text is empty or not the name of a valid member.
+//
+// If e has no allowed values, they're taken from the Enum registered for T (see Register).
+// If e is Strict, returns an *InvalidNameError for text that is not empty and not a valid name.
+// Aliases and case-insensitive matching are supported as configured by WithAliases and FoldCase.
 func (e *Enum[T]) UnmarshalText(text []byte) error {
-	s := string(text)
-	for v, m := range e.members {
-		if m.name == s {
-			e.Value = optional.OfOk(v)
-			return nil
-		}
+	e.resolve()
+	v, ok := e.lookup(string(text))
+	e.Value = optional.Of(v, ok)
+	if !ok && e.decl != nil && e.decl.strict && len(text) > 0 {
+		return e.invalidName(string(text))
 	}
-	e.Value = optional.OfNotOk[T]()
 	return nil
 }
+
+// MarshalJSON encodes e as JSON.
+// Marshals the underlying value if ok,
+// the literal null if not ok or not a member of the allowed values.
+// If e encodes JSONNames, marshals the name of the current member as a string instead.
+func (e Enum[T]) MarshalJSON() ([]byte, error) {
+	var name string
+	if !e.name().Ok(&name) {
+		return []byte("null"), nil
+	}
+	if e.decl.json {
+		return json.Marshal(name)
+	}
+	return e.Value.MarshalJSON()
+}
+
+// UnmarshalJSON decodes data into e.
+// data must be an allowed value, or a name if e encodes JSONNames,
+// which is decoded as specified by UnmarshalText.
+// Does nothing if data is the literal null.
+//
+// If e has no allowed values, they're taken from the Enum registered for T (see Register).
+// If e is Strict, returns an error for data that is not a member.
+// Otherwise, sets e to not-ok.
+func (e *Enum[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		// by convention, null is no-op
+		return nil
+	}
+	e.resolve()
+	if e.decl != nil && e.decl.json {
+		var s string
+		if err := json.Unmarshal(data, &s); err != nil {
+			return err
+		}
+		return e.UnmarshalText([]byte(s))
+	}
+	// unmarshal into temp first in case of error
+	var temp T
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	return e.setValue(temp)
+}
@@ -1,10 +1,14 @@
 package enum_test
 
 import (
+	"bytes"
 	"fmt"
 	"github.com/phelmkamp/valor/enum"
+	"github.com/phelmkamp/valor/optional"
 	"github.com/phelmkamp/valor/tuple/two"
+	"log/slog"
 	"reflect"
+	"slices"
 	"testing"
 	"time"
 )
@@ -45,10 +49,10 @@ func Example() {
 	fmt.Println(Event.ValueOf(time.UnixMilli(0).UTC()))
 	// Output:
 	// [clubs diamonds hearts spades]
-	// { false}
-	// {hearts true}
-	// {orange true}
-	// {1970-01-01T00:00:00Z true}
+	// None
+	// Some(hearts)
+	// Some(orange)
+	// Some(1970-01-01T00:00:00Z)
 }
 
 // Example_marshal demonstrates that an enum.Enum can be marshaled to and unmarshaled from text (and therefore JSON).
@@ -58,7 +62,7 @@ func Example_marshal() {
 	fav := Fruit
 	_ = fav.UnmarshalText(text)
 	fmt.Println(fav)
-	// Output: {apple true}
+	// Output: Some(apple)
 }
 
 func Test_marshal(t *testing.T) {
@@ -85,3 +89,237 @@ func Test_marshal(t *testing.T) {
 		t.Errorf("fav after UnmarshalText() = %v, want %v", fav, Fruit.ValueOf(Apple))
 	}
 }
+
+func TestEnum_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		e      any
+		want   string
+	}{
+		{name: "v", format: "%v", e: Fruit.ValueOf(Orange), want: "Some(orange)"},
+		{name: "v not ok", format: "%v", e: Fruit, want: "None"},
+		{name: "plus", format: "%+v", e: Fruit.ValueOf(Orange), want: "Some(orange=6)"},
+		{name: "bare", format: "%v", e: Fruit.ValueOf(Orange).Bare(), want: "orange"},
+		{name: "bare not ok", format: "%v", e: Fruit.Bare(), want: "<none>"},
+		{name: "width", format: "%-10v|", e: Fruit.ValueOf(Orange), want: "Some(orange)|"},
+		{name: "width bare", format: "%8v", e: Fruit.ValueOf(Orange).Bare(), want: "  orange"},
+		{name: "q", format: "%q", e: Suit.ValueOf(Hearts), want: `Some("hearts")`},
+		{
+			name:   "go",
+			format: "%#v",
+			e:      Fruit.ValueOf(Banana),
+			want:   `enum.Of[int](two.TupleOf[string, int]("apple", 4), two.TupleOf[string, int]("banana", 5), two.TupleOf[string, int]("orange", 6)).ValueOf(5)`,
+		},
+		{
+			name:   "go not ok",
+			format: "%#v",
+			e:      enum.OfString("a"),
+			want:   `enum.Of[string](two.TupleOf[string, string]("a", "a"))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.e); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnum_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("msg", "ok", Fruit.ValueOf(Orange), "not_ok", Fruit)
+	if got, want := buf.String(), "level=INFO msg=msg ok=orange\n"; got != want {
+		t.Errorf("log output = %q, want %q", got, want)
+	}
+}
+
+// Example_ordinal demonstrates that the declaration order of an enum.Enum can drive a state machine.
+func Example_ordinal() {
+	state := Suit.ValueAt(0)
+	for range Suit.Len() + 1 {
+		fmt.Println(state.Ordinal().OrZero(), state)
+		state = state.Next(true)
+	}
+	fmt.Println(Suit.Names(), Suit.NameOf(Spades), Suit.NameOf("Foo"))
+	// Output:
+	// 0 Some(clubs)
+	// 1 Some(diamonds)
+	// 2 Some(hearts)
+	// 3 Some(spades)
+	// 0 Some(clubs)
+	// [clubs diamonds hearts spades] Some(spades) None
+}
+
+func TestEnum_Ordinal(t *testing.T) {
+	if got := Fruit.Ordinal(); got != optional.OfNotOk[int]() {
+		t.Errorf("Ordinal() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+	if got := Fruit.ValueOf(Orange).Ordinal(); got != optional.OfOk(2) {
+		t.Errorf("Ordinal() = %v, want %v", got, optional.OfOk(2))
+	}
+}
+
+func TestEnum_ValueAt(t *testing.T) {
+	for _, i := range []int{-1, 3} {
+		if got := Fruit.ValueAt(i); got.IsOk() {
+			t.Errorf("ValueAt(%d) = %v, want %v", i, got, Fruit)
+		}
+	}
+	if got := Fruit.ValueAt(1); !reflect.DeepEqual(got, Fruit.ValueOf(Banana)) {
+		t.Errorf("ValueAt(1) = %v, want %v", got, Fruit.ValueOf(Banana))
+	}
+}
+
+func TestEnum_Next(t *testing.T) {
+	tests := []struct {
+		name string
+		e    enum.Enum[int]
+		wrap bool
+		want enum.Enum[int]
+	}{
+		{name: "not ok", e: Fruit, wrap: true, want: Fruit},
+		{name: "first", e: Fruit.ValueOf(Apple), want: Fruit.ValueOf(Banana)},
+		{name: "last", e: Fruit.ValueOf(Orange), want: Fruit},
+		{name: "last wrap", e: Fruit.ValueOf(Orange), wrap: true, want: Fruit.ValueOf(Apple)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.Next(tt.wrap); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Next() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnum_Prev(t *testing.T) {
+	tests := []struct {
+		name string
+		e    enum.Enum[int]
+		wrap bool
+		want enum.Enum[int]
+	}{
+		{name: "not ok", e: Fruit, wrap: true, want: Fruit},
+		{name: "last", e: Fruit.ValueOf(Orange), want: Fruit.ValueOf(Banana)},
+		{name: "first", e: Fruit.ValueOf(Apple), want: Fruit},
+		{name: "first wrap", e: Fruit.ValueOf(Apple), wrap: true, want: Fruit.ValueOf(Orange)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.Prev(tt.wrap); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Prev() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnum_Len(t *testing.T) {
+	if got := Fruit.Len(); got != 3 {
+		t.Errorf("Len() = %v, want %v", got, 3)
+	}
+	if got := (enum.Enum[int]{}).Len(); got != 0 {
+		t.Errorf("Len() = %v, want %v", got, 0)
+	}
+}
+
+func TestEnum_comparable(t *testing.T) {
+	if Fruit.ValueOf(Apple) != Fruit.ValueOf(Apple) {
+		t.Errorf("ValueOf(Apple) != ValueOf(Apple)")
+	}
+	if Fruit.ValueOf(Apple) == Fruit.ValueOf(Banana) {
+		t.Errorf("ValueOf(Apple) == ValueOf(Banana)")
+	}
+	var fav enum.Enum[int]
+	if fav == Fruit {
+		t.Errorf("zero Enum == Fruit")
+	}
+}
+
+func TestEnum_allocs(t *testing.T) {
+	fav := Fruit
+	text := []byte("orange")
+	allocs := testing.AllocsPerRun(100, func() {
+		for range Fruit.All() {
+		}
+		_ = fav.UnmarshalText(text)
+	})
+	if allocs != 0 {
+		t.Errorf("allocs = %v, want %v", allocs, 0)
+	}
+}
+
+func TestEnum_nonMember(t *testing.T) {
+	tests := []struct {
+		name string
+		e    enum.Enum[int]
+	}{
+		{name: "non-member", e: enum.Enum[int]{Value: optional.OfOk(99)}},
+		{name: "non-member with decl", e: func() enum.Enum[int] {
+			e := Fruit
+			e.Value = optional.OfOk(99)
+			return e
+		}()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.e.String(); got != "None" {
+				t.Errorf("String() = %v, want %v", got, "None")
+			}
+			if got := fmt.Sprintf("%+v", tt.e); got != "None" {
+				t.Errorf("Sprintf(%%+v) = %v, want %v", got, "None")
+			}
+			if got, err := tt.e.MarshalText(); got != nil || err != nil {
+				t.Errorf("MarshalText() = %s %v, want %v %v", got, err, nil, nil)
+			}
+			if got, err := tt.e.MarshalJSON(); string(got) != "null" || err != nil {
+				t.Errorf("MarshalJSON() = %s %v, want %v %v", got, err, "null", nil)
+			}
+			if got := tt.e.LogValue(); got.Kind() != slog.KindGroup || len(got.Group()) != 0 {
+				t.Errorf("LogValue() = %v, want empty group", got)
+			}
+			if got := tt.e.Ordinal(); got.IsOk() {
+				t.Errorf("Ordinal() = %v, want not ok", got)
+			}
+		})
+	}
+}
+
+func TestEnum_Values_copy(t *testing.T) {
+	vals, names := Fruit.Values(), Fruit.Names()
+	slices.Reverse(vals)
+	slices.Reverse(names)
+	if got, want := Fruit.Values(), []int{Apple, Banana, Orange}; !slices.Equal(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	if got, want := Fruit.Names(), []string{"apple", "banana", "orange"}; !slices.Equal(got, want) {
+		t.Errorf("Names() = %v, want %v", got, want)
+	}
+	if got := Fruit.ValueOf(Apple).Ordinal(); got != optional.OfOk(0) {
+		t.Errorf("Ordinal() = %v, want %v", got, optional.OfOk(0))
+	}
+	if got := (enum.Enum[int]{}).Values(); len(got) != 0 {
+		t.Errorf("Values() = %v, want empty", got)
+	}
+}
+
+func TestEnum_All(t *testing.T) {
+	var got []string
+	for name, v := range Fruit.All() {
+		got = append(got, fmt.Sprint(name, v))
+		if v == Banana {
+			break
+		}
+	}
+	if want := []string{"apple4", "banana5"}; !slices.Equal(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/phelmkamp/valor/internal/format"
+	"github.com/phelmkamp/valor/optional"
+)
+
+// registry maps a value type to its registered Enum.
+var registry sync.Map // map[reflect.Type]any
+
+// Register declares e as the Enum of the value type T and returns e.
+// A zero-value Enum[T] takes its allowed values (and options such as Strict) from e
+// when it's decoded by UnmarshalText, UnmarshalJSON, UnmarshalXML, UnmarshalXMLAttr or Scan.
+// This enables the use of Enum[T] fields in structs that are decoded without being pre-populated.
+//
+// T should be a named type dedicated to the enum, e.g.
+//
+//	type Suit string
+//	var Suits = enum.Register(enum.Of(two.TupleOf("hearts", Suit("hearts")), ...))
+//
+// Panics if an Enum is already registered for T.
+func Register[T comparable](e Enum[T]) Enum[T] {
+	e.Value = optional.OfNotOk[T]()
+	if _, loaded := registry.LoadOrStore(reflect.TypeFor[T](), e); loaded {
+		panic(fmt.Sprintf("enum: duplicate registration of type %s", format.TypeName[T]()))
+	}
+	return e
+}
+
+// Registered returns the Enum registered for T.
+// Returns a not-ok Value if no Enum is registered for T.
+func Registered[T comparable]() optional.Value[Enum[T]] {
+	r, ok := registry.Load(reflect.TypeFor[T]())
+	if !ok {
+		return optional.OfNotOk[Enum[T]]()
+	}
+	return optional.OfOk(r.(Enum[T]))
+}
+
+// resolve sets the allowed values of e to those of the Enum registered for T
+// if e has no allowed values.
+func (e *Enum[T]) resolve() {
+	if e.decl != nil {
+		return
+	}
+	var reg Enum[T]
+	if Registered[T]().Ok(&reg) {
+		reg.Value = e.Value
+		*e = reg
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum_test
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/gob"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"log"
+	"reflect"
+	"testing"
+
+	"github.com/phelmkamp/valor/enum"
+	"github.com/phelmkamp/valor/tuple/two"
+)
+
+type Color int
+
+const (
+	Red Color = iota + 1
+	Green
+	Blue
+)
+
+var Colors = enum.Register(enum.Of(
+	two.TupleOf("red", Red),
+	two.TupleOf("green", Green),
+	two.TupleOf("blue", Blue),
+).JSONNames().Strict())
+
+// type checks
+var (
+	_ json.Unmarshaler    = &enum.Enum[Color]{}
+	_ xml.Unmarshaler     = &enum.Enum[Color]{}
+	_ xml.UnmarshalerAttr = &enum.Enum[Color]{}
+	_ sql.Scanner         = &enum.Enum[Color]{}
+	_ gob.GobDecoder      = &enum.Enum[Color]{}
+)
+
+// ExampleRegister demonstrates that a zero-value enum.Enum field can be decoded once its type is registered.
+func ExampleRegister() {
+	type Shirt struct {
+		Color enum.Enum[Color] `json:"color"`
+	}
+	var shirt Shirt
+	if err := json.Unmarshal([]byte(`{"color":"green"}`), &shirt); err != nil {
+		log.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	fmt.Println(shirt.Color, shirt.Color.Ordinal())
+	fmt.Println(json.Unmarshal([]byte(`{"color":"pink"}`), &Shirt{}))
+	// Output:
+	// Some(green) Some(1)
+	// enum: invalid name "pink", must be one of: red, green, blue
+}
+
+func TestRegister(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Register() did not panic for duplicate registration")
+		}
+	}()
+	enum.Register(enum.Of(two.TupleOf("black", Color(0))))
+}
+
+func TestRegistered(t *testing.T) {
+	if got := enum.Registered[Color]().OrZero(); !reflect.DeepEqual(got, Colors) {
+		t.Errorf("Registered() = %v, want %v", got, Colors)
+	}
+	if got := enum.Registered[float32](); got.IsOk() {
+		t.Errorf("Registered() = %v, want not ok", got)
+	}
+}
+
+func TestEnum_XML_registered(t *testing.T) {
+	type shirt struct {
+		XMLName xml.Name         `xml:"shirt"`
+		Color   enum.Enum[Color] `xml:"color"`
+		Trim    enum.Enum[Color] `xml:"trim,attr"`
+		Collar  enum.Enum[Color] `xml:"collar"`
+	}
+	want := shirt{Color: Colors.ValueOf(Blue), Trim: Colors.ValueOf(Red), Collar: Colors}
+	b, err := xml.Marshal(want)
+	if err != nil || string(b) != `<shirt trim="red"><color>blue</color></shirt>` {
+		t.Fatalf("xml.Marshal() = %s %v", b, err)
+	}
+	var got shirt
+	if err = xml.Unmarshal(b, &got); err != nil {
+		t.Fatalf("xml.Unmarshal() error = %v", err)
+	}
+	got.XMLName = xml.Name{}
+	if got.Color.String() != "Some(blue)" || got.Trim.String() != "Some(red)" || got.Collar.IsOk() {
+		t.Errorf("xml.Unmarshal() = %v, want %v", got, want)
+	}
+	if err = xml.Unmarshal([]byte(`<shirt trim="pink"></shirt>`), &got); err == nil {
+		t.Errorf("xml.Unmarshal() error = %v, want error", err)
+	}
+}
+
+func TestEnum_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    string
+		wantErr bool
+	}{
+		{name: "nil", src: nil, want: "None"},
+		{name: "string", src: "red", want: "Some(red)"},
+		{name: "bytes", src: []byte("blue"), want: "Some(blue)"},
+		{name: "invalid name", src: "pink", want: "None", wantErr: true},
+		{name: "value", src: int64(2), want: "Some(green)"},
+		{name: "value bytes", src: []byte("3"), want: "Some(blue)"},
+		{name: "value string", src: "1", want: "Some(red)"},
+		{name: "invalid value", src: int64(9), want: "None", wantErr: true},
+		{name: "invalid value bytes", src: []byte("9"), want: "None", wantErr: true},
+		{name: "empty", src: []byte{}, want: "None"},
+		{name: "invalid type", src: 1.5, want: "None", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got enum.Enum[Color]
+			if err := got.Scan(tt.src); (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.String() != tt.want {
+				t.Errorf("e after Scan() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	// lenient
+	got := enum.Of(two.TupleOf("red", Red)).ValueOf(Red)
+	if err := got.Scan(int64(9)); err != nil || got.IsOk() {
+		t.Errorf("Scan() = %v %v, want %v %v", got, err, "None", nil)
+	}
+}
+
+func TestEnum_Gob_registered(t *testing.T) {
+	type shirt struct {
+		Color  enum.Enum[Color]
+		Collar enum.Enum[Color]
+	}
+	var buf bytes.Buffer
+	if err := gob.NewEncoder(&buf).Encode(shirt{Color: Colors.ValueOf(Blue), Collar: Colors}); err != nil {
+		t.Fatalf("Encode() error = %v", err)
+	}
+	var got shirt
+	if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if got.Color != Colors.ValueOf(Blue) || got.Collar.IsOk() || got.Color.String() != "Some(blue)" {
+		t.Errorf("Decode() = %v, want %v", got, shirt{Color: Colors.ValueOf(Blue)})
+	}
+
+	var e enum.Enum[Color]
+	if err := e.GobDecode([]byte("pink")); err == nil {
+		t.Errorf("GobDecode() error = %v, want error", err)
+	}
+	if text, err := (enum.Enum[Color]{}).GobEncode(); len(text) != 0 || err != nil {
+		t.Errorf("GobEncode() = %s %v, want %s %v", text, err, "", nil)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"encoding/json"
+	"fmt"
+	"iter"
+	"math/bits"
+	"strings"
+)
+
+// maxSetLen is the maximum number of allowed values of an Enum that can be used in a Set.
+const maxSetLen = 64
+
+// Set is a set of the allowed values of an Enum, such as a set of bit flags.
+// Each value is represented by the bit at its position in declaration order.
+//
+// Sets are comparable: sets of the same Enum are equal if they contain the same values.
+// Options such as Strict and FoldCase create a copy of the declaration,
+// so sets of Enums with different options are never equal; compare their Bits instead.
+// The binary operations Union, Intersect and Difference require sets of the same declaration,
+// regardless of options, (or a zero Set) and panic otherwise.
+type Set[T comparable] struct {
+	decl *decl[T] // carry allowed values for validation
+	bits uint64
+}
+
+// SetOf returns a Set of the given values of e.
+// Values that are not members of the allowed values are ignored.
+// Panics if e has more than 64 allowed values.
+func (e Enum[T]) SetOf(vals ...T) Set[T] {
+	if e.Len() > maxSetLen {
+		panic(fmt.Sprintf("enum: Set of %d values exceeds maximum of %d", e.Len(), maxSetLen))
+	}
+	return Set[T]{decl: e.decl}.Add(vals...)
+}
+
+// Enum returns the Enum that s is a set of.
+func (s Set[T]) Enum() Enum[T] {
+	return Enum[T]{decl: s.decl}
+}
+
+// Add returns a Set of the values of s and vals.
+// Values that are not members of the allowed values are ignored.
+func (s Set[T]) Add(vals ...T) Set[T] {
+	for _, v := range vals {
+		if i, ok := s.decl.position(v); ok {
+			s.bits |= 1 << i
+		}
+	}
+	return s
+}
+
+// Remove returns a Set of the values of s except vals.
+func (s Set[T]) Remove(vals ...T) Set[T] {
+	for _, v := range vals {
+		if i, ok := s.decl.position(v); ok {
+			s.bits &^= 1 << i
+		}
+	}
+	return s
+}
+
+// Has returns whether s contains v.
+func (s Set[T]) Has(v T) bool {
+	i, ok := s.decl.position(v)
+	return ok && s.bits&(1<<i) != 0
+}
+
+// Union returns a Set of the values that are in either s or s2.
+func (s Set[T]) Union(s2 Set[T]) Set[T] {
+	s.decl = s.join(s2)
+	s.bits |= s2.bits
+	return s
+}
+
+// Intersect returns a Set of the values that are in both s and s2.
+func (s Set[T]) Intersect(s2 Set[T]) Set[T] {
+	s.decl = s.join(s2)
+	s.bits &= s2.bits
+	return s
+}
+
+// Difference returns a Set of the values that are in s but not in s2.
+func (s Set[T]) Difference(s2 Set[T]) Set[T] {
+	s.decl = s.join(s2)
+	s.bits &^= s2.bits
+	return s
+}
+
+// join returns the declaration of the result of a binary operation on s and s2.
+// A zero Set takes the declaration of the other operand, e.g. when accumulating a Union.
+// Panics if s and s2 are sets of different declarations.
+func (s Set[T]) join(s2 Set[T]) *decl[T] {
+	switch {
+	case s.decl == nil:
+		return s2.decl
+	case s2.decl == nil || s.decl.same(s2.decl):
+		return s.decl
+	}
+	panic("enum: Set operation on sets of different declarations")
+}
+
+// Len returns the number of values in s.
+func (s Set[T]) Len() int {
+	return bits.OnesCount64(s.bits)
+}
+
+// IsEmpty returns whether s contains no values.
+func (s Set[T]) IsEmpty() bool {
+	return s.bits == 0
+}
+
+// Bits returns the bitset that represents s.
+// Bit i is set if s contains the allowed value at position i.
+func (s Set[T]) Bits() uint64 {
+	return s.bits
+}
+
+// All returns an iterator over the values of s in declaration order.
+func (s Set[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for b := s.bits; b != 0; b &= b - 1 {
+			if !yield(s.decl.values[bits.TrailingZeros64(b)]) {
+				return
+			}
+		}
+	}
+}
+
+// Values returns the values of s in declaration order.
+func (s Set[T]) Values() []T {
+	vals := make([]T, 0, s.Len())
+	for v := range s.All() {
+		vals = append(vals, v)
+	}
+	return vals
+}
+
+// Names returns the names of the values of s in declaration order.
+func (s Set[T]) Names() []string {
+	names := make([]string, 0, s.Len())
+	for b := s.bits; b != 0; b &= b - 1 {
+		names = append(names, s.decl.names[bits.TrailingZeros64(b)])
+	}
+	return names
+}
+
+// String returns the names of the values of s separated by "|", e.g. "read|write".
+func (s Set[T]) String() string {
+	return strings.Join(s.Names(), "|")
+}
+
+// MarshalText returns the names of the values of s separated by "|", e.g. "read|write".
+func (s Set[T]) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// UnmarshalText sets s to the values with the names in text, which are separated by "|".
+// Names are matched as specified by Enum.UnmarshalText.
+// Invalid names are ignored unless the Enum is Strict, in which case an *InvalidNameError is returned.
+func (s *Set[T]) UnmarshalText(text []byte) error {
+	var names []string
+	if len(text) > 0 {
+		names = strings.Split(string(text), "|")
+	}
+	return s.setNames(names)
+}
+
+// MarshalJSON encodes s as a JSON array of the names of its values.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Names())
+}
+
+// UnmarshalJSON decodes data into s.
+// data must be either an array of names or a string that's decoded as specified by UnmarshalText.
+// Does nothing if data is the literal null.
+func (s *Set[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		// by convention, null is no-op
+		return nil
+	}
+	var text string
+	if err := json.Unmarshal(data, &text); err == nil {
+		return s.UnmarshalText([]byte(text))
+	}
+	var names []string
+	if err := json.Unmarshal(data, &names); err != nil {
+		return err
+	}
+	return s.setNames(names)
+}
+
+// setNames sets s to the values with the given names.
+// If s has no allowed values, they're taken from the Enum registered for T (see Register).
+func (s *Set[T]) setNames(names []string) error {
+	e := s.Enum()
+	e.resolve()
+	if e.Len() > maxSetLen {
+		return fmt.Errorf("enum: Set of %d values exceeds maximum of %d", e.Len(), maxSetLen)
+	}
+	set := Set[T]{decl: e.decl}
+	for _, name := range names {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		i, ok := set.decl.lookup(name)
+		if !ok {
+			if set.decl != nil && set.decl.strict {
+				return e.invalidName(name)
+			}
+			continue
+		}
+		set.bits |= 1 << i
+	}
+	*s = set
+	return nil
+}
@@ -0,0 +1,211 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum_test
+
+import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/phelmkamp/valor/enum"
+	"github.com/phelmkamp/valor/tuple/two"
+)
+
+type Perm uint8
+
+const (
+	Read Perm = iota
+	Write
+	Exec
+)
+
+var Perms = enum.Register(enum.Of(
+	two.TupleOf("read", Read),
+	two.TupleOf("write", Write),
+	two.TupleOf("exec", Exec),
+).Strict())
+
+// type checks
+var (
+	_ encoding.TextMarshaler   = enum.Set[Perm]{}
+	_ encoding.TextUnmarshaler = &enum.Set[Perm]{}
+	_ json.Marshaler           = enum.Set[Perm]{}
+	_ json.Unmarshaler         = &enum.Set[Perm]{}
+)
+
+func ExampleSet() {
+	type File struct {
+		Perms enum.Set[Perm] `json:"perms"`
+	}
+	f := File{Perms: Perms.SetOf(Exec, Read)}
+	b, _ := json.Marshal(f)
+	fmt.Println(string(b), f.Perms, f.Perms.Has(Write))
+
+	if err := json.Unmarshal([]byte(`{"perms":"read|write"}`), &f); err != nil {
+		log.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	for p := range f.Perms.All() {
+		fmt.Println(p, Perms.NameOf(p))
+	}
+	// Output:
+	// {"perms":["read","exec"]} read|exec false
+	// 0 Some(read)
+	// 1 Some(write)
+}
+
+func TestSet_ops(t *testing.T) {
+	rw := Perms.SetOf(Read, Write)
+	wx := Perms.SetOf(Write, Exec)
+	tests := []struct {
+		name string
+		got  enum.Set[Perm]
+		want []Perm
+	}{
+		{name: "SetOf invalid", got: Perms.SetOf(Perm(9)), want: []Perm{}},
+		{name: "Add", got: rw.Add(Exec, Perm(9)), want: []Perm{Read, Write, Exec}},
+		{name: "Remove", got: rw.Remove(Read, Exec), want: []Perm{Write}},
+		{name: "Union", got: rw.Union(wx), want: []Perm{Read, Write, Exec}},
+		{name: "Intersect", got: rw.Intersect(wx), want: []Perm{Write}},
+		{name: "Difference", got: rw.Difference(wx), want: []Perm{Read}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.got.Values(); !slices.Equal(got, tt.want) {
+				t.Errorf("Values() = %v, want %v", got, tt.want)
+			}
+			if got := tt.got.Len(); got != len(tt.want) {
+				t.Errorf("Len() = %v, want %v", got, len(tt.want))
+			}
+			if got := tt.got.IsEmpty(); got != (len(tt.want) == 0) {
+				t.Errorf("IsEmpty() = %v, want %v", got, len(tt.want) == 0)
+			}
+		})
+	}
+	if rw.Union(wx) != Perms.SetOf(Exec, Write, Read) {
+		t.Errorf("Union() != SetOf()")
+	}
+	if got := rw.Bits(); got != 0b011 {
+		t.Errorf("Bits() = %b, want %b", got, 0b011)
+	}
+	if !rw.Has(Read) || rw.Has(Exec) || rw.Has(Perm(9)) {
+		t.Errorf("Has() = %v %v %v, want %v %v %v", rw.Has(Read), rw.Has(Exec), rw.Has(Perm(9)), true, false, false)
+	}
+	if got := rw.Enum(); got != Perms {
+		t.Errorf("Enum() = %v, want %v", got, Perms)
+	}
+}
+
+func TestSet_All(t *testing.T) {
+	var got []Perm
+	for p := range Perms.SetOf(Read, Write, Exec).All() {
+		got = append(got, p)
+		break
+	}
+	if want := []Perm{Read}; !slices.Equal(got, want) {
+		t.Errorf("All() = %v, want %v", got, want)
+	}
+}
+
+func TestSet_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		s       enum.Set[string]
+		text    string
+		want    []string
+		wantErr bool
+	}{
+		{name: "empty", s: Suit.SetOf(Hearts), text: "", want: []string{}},
+		{name: "names", s: Suit.SetOf(), text: "spades| clubs||", want: []string{Clubs, Spades}},
+		{name: "lenient invalid", s: Suit.SetOf(), text: "clubs|joker", want: []string{Clubs}},
+		{name: "strict invalid", s: Suit.Strict().SetOf(Hearts), text: "clubs|joker", want: []string{Hearts}, wantErr: true},
+		{name: "fold", s: Suit.FoldCase().SetOf(), text: "CLUBS", want: []string{Clubs}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.s
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !slices.Equal(got.Values(), tt.want) {
+				t.Errorf("s after UnmarshalText() = %v, want %v", got.Values(), tt.want)
+			}
+		})
+	}
+}
+
+func TestSet_JSON(t *testing.T) {
+	var got enum.Set[Perm]
+	if err := json.Unmarshal([]byte(`["exec","write"]`), &got); err != nil || got != Perms.SetOf(Write, Exec) {
+		t.Errorf("json.Unmarshal() = %v %v, want %v %v", got, err, Perms.SetOf(Write, Exec), nil)
+	}
+	if err := json.Unmarshal([]byte(`null`), &got); err != nil || got != Perms.SetOf(Write, Exec) {
+		t.Errorf("json.Unmarshal() = %v %v, want %v %v", got, err, Perms.SetOf(Write, Exec), nil)
+	}
+	var invalid *enum.InvalidNameError
+	if err := json.Unmarshal([]byte(`["sudo"]`), &got); !errors.As(err, &invalid) {
+		t.Errorf("json.Unmarshal() error = %v, want %T", err, invalid)
+	}
+	if err := json.Unmarshal([]byte(`1`), &got); err == nil {
+		t.Errorf("json.Unmarshal() error = %v, want error", err)
+	}
+	b, err := json.Marshal(enum.Set[Perm]{})
+	if err != nil || string(b) != `[]` {
+		t.Errorf("json.Marshal() = %s %v, want %s %v", b, err, `[]`, nil)
+	}
+}
+
+func TestEnum_SetOf_tooLarge(t *testing.T) {
+	pairs := make([]two.Tuple[string, int], 65)
+	for i := range pairs {
+		pairs[i] = two.TupleOf(strconv.Itoa(i), i)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("SetOf() did not panic for 65 values")
+		}
+	}()
+	enum.Of(pairs...).SetOf()
+}
+
+func TestSet_zero(t *testing.T) {
+	var s enum.Set[int]
+	if got := s.Add(1); !reflect.DeepEqual(got, s) || got.Len() != 0 || got.String() != "" {
+		t.Errorf("Add() = %v, want empty", got)
+	}
+}
+
+func TestSet_join(t *testing.T) {
+	var acc enum.Set[Perm]
+	acc = acc.Union(Perms.SetOf(Write))
+	if !acc.Has(Write) || acc.String() != "write" || acc != Perms.SetOf(Write) {
+		t.Errorf("Union() = %v, want %v", acc, Perms.SetOf(Write))
+	}
+	if got := acc.Union(enum.Set[Perm]{}); got != acc {
+		t.Errorf("Union() = %v, want %v", got, acc)
+	}
+	if got := (enum.Set[Perm]{}).Intersect(acc); got != Perms.SetOf() {
+		t.Errorf("Intersect() = %v, want %v", got, Perms.SetOf())
+	}
+	if got := Perms.FoldCase().SetOf(Read).Difference(acc); got.String() != "read" {
+		t.Errorf("Difference() = %v, want %v", got, "read")
+	}
+	if strict := Perms.Strict().SetOf(Write); strict == acc || strict.Bits() != acc.Bits() {
+		t.Errorf("Strict().SetOf() = %v, want unequal with equal Bits to %v", strict, acc)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Union() did not panic for different declarations")
+		}
+	}()
+	other := enum.Of(two.TupleOf("read", Read), two.TupleOf("write", Write))
+	acc.Union(other.SetOf(Read))
+}
@@ -0,0 +1,134 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+
+	"github.com/phelmkamp/valor/optional"
+	"github.com/phelmkamp/valor/result"
+	"github.com/phelmkamp/valor/tuple/two"
+)
+
+// InvalidNameError reports a name that does not match any of the allowed values.
+type InvalidNameError struct {
+	Name    string   // the invalid name
+	Allowed []string // the names of the allowed values in declaration order
+}
+
+// Error returns the invalid name and the allowed names.
+func (err *InvalidNameError) Error() string {
+	return fmt.Sprintf("enum: invalid name %q, must be one of: %s", err.Name, strings.Join(err.Allowed, ", "))
+}
+
+// InvalidValueError reports a value that is not one of the allowed values.
+type InvalidValueError struct {
+	Value   any   // the invalid value
+	Allowed []any // the allowed values in declaration order
+}
+
+// Error returns the invalid value and the allowed values.
+func (err *InvalidValueError) Error() string {
+	allowed := make([]string, len(err.Allowed))
+	for i, v := range err.Allowed {
+		allowed[i] = fmt.Sprint(v)
+	}
+	return fmt.Sprintf("enum: invalid value %v, must be one of: %s", err.Value, strings.Join(allowed, ", "))
+}
+
+// Strict returns a copy of e that reports invalid input when decoding.
+// UnmarshalText of the copy returns an *InvalidNameError
+// and UnmarshalJSON returns an *InvalidNameError or *InvalidValueError, depending on JSONNames,
+// instead of silently setting it to not-ok.
+func (e Enum[T]) Strict() Enum[T] {
+	d := e.decl.clone()
+	d.strict = true
+	e.decl = d
+	return e
+}
+
+// FoldCase returns a copy of e that matches names case-insensitively, e.g. "Hearts" matches "hearts".
+// An exact match takes precedence over a case-insensitive one.
+func (e Enum[T]) FoldCase() Enum[T] {
+	d := e.decl.clone()
+	d.fold = true
+	d.index()
+	e.decl = d
+	return e
+}
+
+// JSONNames returns a copy of e that is encoded as JSON by the name of its member, e.g. "hearts",
+// rather than by its underlying value.
+// This enables aliases and case-insensitive matching when decoding JSON.
+func (e Enum[T]) JSONNames() Enum[T] {
+	d := e.decl.clone()
+	d.json = true
+	e.decl = d
+	return e
+}
+
+// WithAliases returns a copy of e that also accepts the given name-value pairs when matching names.
+// An alias does not change the name that a value is marshaled to.
+// Panics if the value of a pair is not a member of the allowed values.
+func (e Enum[T]) WithAliases(pairs ...two.Tuple[string, T]) Enum[T] {
+	d := e.decl.clone()
+	d.aliases = maps.Clone(d.aliases)
+	if d.aliases == nil {
+		d.aliases = make(map[string]int, len(pairs))
+	}
+	for _, p := range pairs {
+		i, ok := d.position(p.V2)
+		if !ok {
+			panic(fmt.Sprintf("enum: alias %q of non-member %v", p.V, p.V2))
+		}
+		d.aliases[p.V] = i
+	}
+	d.index()
+	e.decl = d
+	return e
+}
+
+// Parse returns an Enum that wraps the member with the given name.
+// Returns an *InvalidNameError if name is not a valid name, regardless of whether e is Strict.
+func (e Enum[T]) Parse(name string) result.Result[Enum[T]] {
+	v, ok := e.lookup(name)
+	if !ok {
+		return result.OfError[Enum[T]](e.invalidName(name))
+	}
+	e.Value = optional.OfOk(v)
+	return result.OfOk(e)
+}
+
+// lookup returns the member with the given name or alias.
+// Returns false if there is no such member.
+func (e Enum[T]) lookup(name string) (T, bool) {
+	i, ok := e.decl.lookup(name)
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	return e.decl.values[i], true
+}
+
+// invalidName returns an *InvalidNameError for name.
+func (e Enum[T]) invalidName(name string) error {
+	return &InvalidNameError{Name: name, Allowed: e.Names()}
+}
+
+// setValue sets e to wrap v if v is a member of the allowed values.
+// Sets e to not-ok otherwise, and returns an *InvalidValueError if e is Strict.
+func (e *Enum[T]) setValue(v T) error {
+	*e = e.ValueOf(v)
+	if e.IsOk() || e.decl == nil || !e.decl.strict {
+		return nil
+	}
+	allowed := make([]any, e.Len())
+	for i, v := range e.decl.values {
+		allowed[i] = v
+	}
+	return &InvalidValueError{Value: v, Allowed: allowed}
+}
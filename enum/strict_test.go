@@ -0,0 +1,156 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package enum_test
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/phelmkamp/valor/enum"
+	"github.com/phelmkamp/valor/tuple/two"
+)
+
+// Example_strict demonstrates that a Strict enum.Enum rejects invalid names at the JSON boundary.
+func Example_strict() {
+	type Order struct {
+		Fruit enum.Enum[int] `json:"fruit"`
+	}
+	order := Order{Fruit: Fruit.JSONNames().Strict().FoldCase().WithAliases(two.TupleOf("tangerine", Orange))}
+	for _, data := range []string{`{"fruit":"Apple"}`, `{"fruit":"tangerine"}`, `{"fruit":"kiwi"}`} {
+		if err := json.Unmarshal([]byte(data), &order); err != nil {
+			fmt.Println(err)
+			continue
+		}
+		b, _ := json.Marshal(order)
+		fmt.Println(string(b))
+	}
+	// Output:
+	// {"fruit":"apple"}
+	// {"fruit":"orange"}
+	// enum: invalid name "kiwi", must be one of: apple, banana, orange
+}
+
+func TestEnum_UnmarshalText_strict(t *testing.T) {
+	tests := []struct {
+		name    string
+		e       enum.Enum[int]
+		text    string
+		want    enum.Enum[int]
+		wantErr bool
+	}{
+		{name: "lenient invalid", e: Fruit, text: "kiwi", want: Fruit},
+		{name: "strict valid", e: Fruit.Strict(), text: "apple", want: Fruit.Strict().ValueOf(Apple)},
+		{name: "strict empty", e: Fruit.Strict().ValueOf(Apple), text: "", want: Fruit.Strict()},
+		{name: "strict invalid", e: Fruit.Strict(), text: "kiwi", want: Fruit.Strict(), wantErr: true},
+		{name: "strict case", e: Fruit.Strict(), text: "APPLE", want: Fruit.Strict(), wantErr: true},
+		{name: "fold", e: Fruit.FoldCase(), text: "APPLE", want: Fruit.FoldCase().ValueOf(Apple)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.e
+			err := got.UnmarshalText([]byte(tt.text))
+			if (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("e after UnmarshalText() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEnum_WithAliases(t *testing.T) {
+	e := Suit.WithAliases(two.TupleOf("Heart", Hearts))
+	if got := e.Parse("Heart").Value().OrZero(); !reflect.DeepEqual(got, e.ValueOf(Hearts)) {
+		t.Errorf("Parse() = %v, want %v", got, e.ValueOf(Hearts))
+	}
+	if got := e.Parse("heart"); !got.IsError() {
+		t.Errorf("Parse() = %v, want error", got)
+	}
+	if got := e.FoldCase().Parse("heart").Value().OrZero(); !got.IsOk() {
+		t.Errorf("Parse() = %v, want %v", got, e.ValueOf(Hearts))
+	}
+	if got, _ := e.ValueOf(Hearts).MarshalText(); string(got) != Hearts {
+		t.Errorf("MarshalText() = %s, want %s", got, Hearts)
+	}
+	if got := Suit.Parse("Heart"); !got.IsError() {
+		t.Errorf("Parse() = %v, want error", got)
+	}
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("WithAliases() did not panic for non-member")
+		}
+	}()
+	Suit.WithAliases(two.TupleOf("joker", "joker"))
+}
+
+func TestEnum_Parse(t *testing.T) {
+	var invalid *enum.InvalidNameError
+	if err := Fruit.Parse("kiwi").Error(); !errors.As(err, &invalid) {
+		t.Fatalf("Parse() error = %v, want %T", err, invalid)
+	}
+	if want := []string{"apple", "banana", "orange"}; invalid.Name != "kiwi" || !reflect.DeepEqual(invalid.Allowed, want) {
+		t.Errorf("InvalidNameError = %+v, want %v %v", invalid, "kiwi", want)
+	}
+	if got := Fruit.Parse("banana").Value().OrZero(); !reflect.DeepEqual(got, Fruit.ValueOf(Banana)) {
+		t.Errorf("Parse() = %v, want %v", got, Fruit.ValueOf(Banana))
+	}
+}
+
+func TestEnum_JSON(t *testing.T) {
+	tests := []struct {
+		name    string
+		e       enum.Enum[int]
+		data    string
+		want    enum.Enum[int]
+		wantErr bool
+	}{
+		{name: "value", e: Fruit, data: `5`, want: Fruit.ValueOf(Banana)},
+		{name: "null", e: Fruit.ValueOf(Apple), data: `null`, want: Fruit.ValueOf(Apple)},
+		{name: "non-member", e: Fruit.ValueOf(Apple), data: `1`, want: Fruit},
+		{name: "name", e: Fruit, data: `"banana"`, want: Fruit, wantErr: true},
+		{name: "strict value", e: Fruit.Strict(), data: `5`, want: Fruit.Strict().ValueOf(Banana)},
+		{name: "strict non-member", e: Fruit.Strict(), data: `1`, want: Fruit.Strict(), wantErr: true},
+		{name: "names", e: Fruit.JSONNames(), data: `"banana"`, want: Fruit.JSONNames().ValueOf(Banana)},
+		{name: "names value", e: Fruit.JSONNames(), data: `5`, want: Fruit.JSONNames(), wantErr: true},
+		{name: "names invalid", e: Fruit.JSONNames().ValueOf(Apple), data: `"kiwi"`, want: Fruit.JSONNames()},
+		{name: "strict names invalid", e: Fruit.JSONNames().Strict(), data: `"kiwi"`, want: Fruit.JSONNames().Strict(), wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := tt.e
+			if err := json.Unmarshal([]byte(tt.data), &got); (err != nil) != tt.wantErr {
+				t.Errorf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("json.Unmarshal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	b, err := json.Marshal([]enum.Enum[int]{Fruit.ValueOf(Banana), Fruit, Fruit.JSONNames().ValueOf(Banana)})
+	if want := `[5,null,"banana"]`; err != nil || string(b) != want {
+		t.Errorf("json.Marshal() = %s %v, want %s %v", b, err, want, nil)
+	}
+	var invalid *enum.InvalidValueError
+	got := Fruit.Strict()
+	if err = got.UnmarshalJSON([]byte(`1`)); !errors.As(err, &invalid) {
+		t.Fatalf("UnmarshalJSON() error = %v, want %T", err, invalid)
+	}
+	if want := "enum: invalid value 1, must be one of: 4, 5, 6"; invalid.Error() != want {
+		t.Errorf("Error() = %v, want %v", invalid.Error(), want)
+	}
+}
+
+func TestEnum_GoString_options(t *testing.T) {
+	e := enum.OfString("a").WithAliases(two.TupleOf("A", "a")).FoldCase().JSONNames().Strict()
+	want := `enum.Of[string](two.TupleOf[string, string]("a", "a")).WithAliases(two.TupleOf[string, string]("A", "a")).FoldCase().JSONNames().Strict()`
+	if got := fmt.Sprintf("%#v", e); got != want {
+		t.Errorf("GoString() = %v, want %v", got, want)
+	}
+}
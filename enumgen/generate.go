@@ -0,0 +1,355 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/format"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"text/template"
+	"unicode"
+	"unicode/utf8"
+)
+
+// config configures generate.
+type config struct {
+	dir         string   // package directory
+	types       []string // names of the types to generate enums for
+	trimPrefix  string   // prefix to remove from identifiers
+	exclude     string   // name of a file to ignore, i.e. the previous output
+	allowAppend bool     // whether to allow iota blocks that don't end with an excluded constant
+	args        []string // command-line arguments, recorded in the header
+}
+
+// member is a constant of an enum type.
+type member struct {
+	Ident string // identifier of the constant
+	Name  string // name of the enum member, or "" if excluded
+	Value string // value of the constant as Go syntax
+}
+
+// enumType is an enum type to generate.
+type enumType struct {
+	Name             string   // name of the type
+	Recv             string   // name of the method receiver
+	Verb             string   // fmt verb for the underlying value
+	Underlying       string   // underlying type
+	Members          []member // constants in declaration order
+	HasString        bool     // whether the type already declares String
+	HasMarshalText   bool     // whether the type already declares MarshalText
+	HasUnmarshalText bool     // whether the type already declares UnmarshalText
+}
+
+// tagPattern matches a name tag in a comment, e.g. enum:"hearts".
+var tagPattern = regexp.MustCompile(`enum:"([^"]*)"`)
+
+// generate returns the formatted source of the enum declarations specified by cfg.
+func generate(cfg config) ([]byte, error) {
+	fset := token.NewFileSet()
+	files, prev, err := parsePackage(fset, cfg.dir, cfg.exclude)
+	if err != nil {
+		return nil, err
+	}
+	all := files
+	if prev != nil {
+		// check the previous output last so that conflicts with it are reported there
+		all = append(slices.Clip(files), prev)
+	}
+	var typeErr error
+	info := &types.Info{Defs: make(map[*ast.Ident]types.Object)}
+	tc := types.Config{
+		Importer: importer.ForCompiler(fset, "source", nil),
+		Error: func(err error) {
+			// tolerate errors in the previous output, which is stale if the constants have changed
+			if terr, ok := err.(types.Error); ok && isFile(fset, terr.Pos, cfg.exclude) {
+				return
+			}
+			if typeErr == nil {
+				typeErr = err
+			}
+		},
+	}
+	pkg, _ := tc.Check(files[0].Name.Name, fset, all, info)
+	if typeErr != nil {
+		return nil, typeErr
+	}
+
+	var enums []enumType
+	for _, name := range cfg.types {
+		et, err := newEnumType(fset, pkg, files, info, name, cfg)
+		if err != nil {
+			return nil, err
+		}
+		enums = append(enums, et)
+	}
+
+	var buf bytes.Buffer
+	err = fileTemplate.Execute(&buf, map[string]any{
+		"Args":    strings.Join(cfg.args, " "),
+		"Package": pkg.Name(),
+		"Enums":   enums,
+		"NeedFmt": slices.ContainsFunc(enums, func(et enumType) bool {
+			return !et.HasString || !et.HasMarshalText
+		}),
+	})
+	if err != nil {
+		return nil, err
+	}
+	src, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting output: %w", err)
+	}
+	return src, nil
+}
+
+// parsePackage parses the non-test Go files in dir in name order.
+// The file named exclude, i.e. the previous output, is returned separately as prev,
+// or nil if it doesn't exist or can't be parsed.
+func parsePackage(fset *token.FileSet, dir, exclude string) (files []*ast.File, prev *ast.File, err error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, nil, err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.ParseComments)
+		switch {
+		case name == exclude:
+			if err == nil {
+				prev = f
+			}
+			continue
+		case err != nil:
+			return nil, nil, err
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, nil, fmt.Errorf("no Go files in %s", dir)
+	}
+	return files, prev, nil
+}
+
+// isFile reports whether pos is in the file with the given base name.
+func isFile(fset *token.FileSet, pos token.Pos, name string) bool {
+	f := fset.File(pos)
+	return f != nil && filepath.Base(f.Name()) == name
+}
+
+// newEnumType collects the constants of the named type in declaration order.
+//
+// Unless cfg.allowAppend is set, returns an error if a const block of the type is unprotected,
+// because a constant added to it would go undetected by the generated check (see isProtected).
+func newEnumType(fset *token.FileSet, pkg *types.Package, files []*ast.File, info *types.Info, name string, cfg config) (enumType, error) {
+	tn, ok := pkg.Scope().Lookup(name).(*types.TypeName)
+	if !ok {
+		return enumType{}, fmt.Errorf("type %s not found", name)
+	}
+	basic, ok := tn.Type().Underlying().(*types.Basic)
+	if !ok || basic.Info()&types.IsConstType == 0 {
+		return enumType{}, fmt.Errorf("type %s cannot have constants", name)
+	}
+	et := enumType{Name: name, Recv: receiverName(name), Verb: "%v", Underlying: basic.Name()}
+	if basic.Info()&types.IsString != 0 {
+		et.Verb = "%q"
+	}
+	if named, ok := tn.Type().(*types.Named); ok {
+		for m := range named.Methods() {
+			if isFile(fset, m.Pos(), cfg.exclude) {
+				// previously generated
+				continue
+			}
+			switch m.Name() {
+			case "String":
+				et.HasString = true
+			case "MarshalText":
+				et.HasMarshalText = true
+			case "UnmarshalText":
+				et.HasUnmarshalText = true
+			}
+		}
+	}
+
+	var unprotected token.Pos // first const block that isProtected doesn't apply to
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			gd, ok := decl.(*ast.GenDecl)
+			if !ok || gd.Tok != token.CONST {
+				continue
+			}
+			n := len(et.Members)
+			for _, spec := range gd.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for _, ident := range vs.Names {
+					obj, ok := info.Defs[ident].(*types.Const)
+					if !ok || ident.Name == "_" || !types.Identical(obj.Type(), tn.Type()) {
+						continue
+					}
+					et.Members = append(et.Members, member{
+						Ident: ident.Name,
+						Name:  memberName(ident.Name, cfg.trimPrefix, vs),
+						Value: constantLiteral(obj.Val()),
+					})
+				}
+			}
+			if len(et.Members) > n && !unprotected.IsValid() && !isProtected(gd, info, tn.Type(), cfg.trimPrefix) {
+				unprotected = gd.Pos()
+			}
+		}
+	}
+	if len(et.Members) == 0 {
+		return enumType{}, fmt.Errorf("no constants of type %s", name)
+	}
+	if !slices.ContainsFunc(et.Members, func(m member) bool { return m.Name != "" }) {
+		return enumType{}, errors.New("all constants of type " + name + " are excluded")
+	}
+	if unprotected.IsValid() && !cfg.allowAppend {
+		return enumType{}, fmt.Errorf("%s: constants of type %s added to this block would go undetected; "+
+			`end it with an iota constant tagged enum:"-" or use -allowappend`, relPosition(fset, unprotected), name)
+	}
+	return et, nil
+}
+
+// isProtected reports whether the generated check detects constants of type t added to the const block gd.
+// This is the case if gd ends with an excluded constant of type t whose value depends on iota:
+// inserting a constant before it changes its value, which fails the check.
+func isProtected(gd *ast.GenDecl, info *types.Info, t types.Type, trimPrefix string) bool {
+	var values []ast.Expr // values of the last spec, which may be implicitly repeated
+	for _, spec := range gd.Specs {
+		if vs := spec.(*ast.ValueSpec); len(vs.Values) > 0 {
+			values = vs.Values
+		}
+	}
+	vs := gd.Specs[len(gd.Specs)-1].(*ast.ValueSpec)
+	ident := vs.Names[len(vs.Names)-1]
+	obj, ok := info.Defs[ident].(*types.Const)
+	if !ok || !types.Identical(obj.Type(), t) || memberName(ident.Name, trimPrefix, vs) != "" {
+		return false
+	}
+	found := false
+	for _, v := range values {
+		ast.Inspect(v, func(n ast.Node) bool {
+			if ident, ok := n.(*ast.Ident); ok && ident.Name == "iota" {
+				found = true
+			}
+			return !found
+		})
+	}
+	return found
+}
+
+// relPosition returns pos formatted with the base name of its file, e.g. cards.go:10:1.
+func relPosition(fset *token.FileSet, pos token.Pos) string {
+	p := fset.Position(pos)
+	p.Filename = filepath.Base(p.Filename)
+	return p.String()
+}
+
+// memberName returns the name of the constant ident declared by vs.
+// Returns "" if the constant is excluded.
+func memberName(ident, trimPrefix string, vs *ast.ValueSpec) string {
+	for _, cg := range []*ast.CommentGroup{vs.Comment, vs.Doc} {
+		if cg == nil {
+			continue
+		}
+		if m := tagPattern.FindStringSubmatch(cg.Text()); m != nil {
+			if m[1] == "-" {
+				return ""
+			}
+			return m[1]
+		}
+	}
+	return strings.TrimPrefix(ident, trimPrefix)
+}
+
+// receiverName returns the conventional receiver name for the type name, i.e. its first letter in lower case.
+func receiverName(name string) string {
+	r, _ := utf8.DecodeRuneInString(name)
+	return string(unicode.ToLower(r))
+}
+
+// constantLiteral returns Go syntax that represents the untyped constant val.
+func constantLiteral(val constant.Value) string {
+	if val.Kind() == constant.Float {
+		if f, ok := constant.Float64Val(val); ok {
+			return fmt.Sprint(f)
+		}
+		// represent the exact value as a quotient
+		return fmt.Sprintf("(%s.0 / %s)", constant.Num(val).ExactString(), constant.Denom(val).ExactString())
+	}
+	return val.ExactString()
+}
+
+var fileTemplate = template.Must(template.New("").Parse(`// Code generated by "enumgen {{.Args}}"; DO NOT EDIT.
+
+package {{.Package}}
+
+import (
+{{- if .NeedFmt}}
+	"fmt"
+{{end}}
+	"github.com/phelmkamp/valor/enum"
+	"github.com/phelmkamp/valor/tuple/two"
+)
+{{range .Enums}}{{$t := .}}
+func _() {
+	// A "duplicate key false" compiler error signifies that the {{.Name}} constants have changed.
+	// Re-run the enumgen command to generate them again.
+	_ = map[bool]struct{}{
+		false: {},
+		{{range $i, $m := .Members}}{{if $i}} &&
+		{{end}}{{$m.Ident}} == {{$m.Value}}{{end}}: {},
+	}
+}
+
+// {{.Name}}Enum is the enum.Enum of the {{.Name}} constants.
+var {{.Name}}Enum = enum.Register(enum.Of(
+{{- range .Members}}{{if .Name}}
+	two.TupleOf({{printf "%q" .Name}}, {{.Ident}}),{{end}}{{end}}
+))
+{{if not .HasString}}
+// String returns the name of {{.Recv}}.
+func ({{.Recv}} {{.Name}}) String() string {
+	return {{.Name}}Enum.NameOf({{.Recv}}).OrElse(func() string {
+		return fmt.Sprintf("{{.Name}}({{.Verb}})", {{.Underlying}}({{.Recv}}))
+	})
+}
+{{end}}{{if not .HasMarshalText}}
+// MarshalText returns the name of {{.Recv}}.
+// Returns an error if {{.Recv}} is not one of the {{.Name}} constants.
+func ({{.Recv}} {{.Name}}) MarshalText() ([]byte, error) {
+	var name string
+	if !{{.Name}}Enum.NameOf({{.Recv}}).Ok(&name) {
+		return nil, fmt.Errorf("invalid {{.Name}}: {{.Verb}}", {{.Underlying}}({{.Recv}}))
+	}
+	return []byte(name), nil
+}
+{{end}}{{if not .HasUnmarshalText}}
+// UnmarshalText sets {{.Recv}} to the constant with the given name.
+// Returns an *enum.InvalidNameError if text is not the name of one of the {{.Name}} constants.
+func ({{.Recv}} *{{.Name}}) UnmarshalText(text []byte) error {
+	val, err := {{.Name}}Enum.Parse(string(text)).Unpack()
+	if err != nil {
+		return err
+	}
+	*{{.Recv}} = val.OrZero()
+	return nil
+}
+{{end}}{{end}}`))
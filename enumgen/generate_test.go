@@ -0,0 +1,219 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/phelmkamp/valor/enumgen/testdata/cards"
+)
+
+func TestGenerate(t *testing.T) {
+	want, err := os.ReadFile(filepath.Join("testdata", "cards", "suit_enum.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(config{
+		dir:         filepath.Join("testdata", "cards"),
+		types:       []string{"Suit", "Rank"},
+		trimPrefix:  "Rank",
+		exclude:     "suit_enum.go",
+		allowAppend: true,
+		args:        []string{"-type=Suit,Rank", "-trimprefix=Rank", "-allowappend"},
+	})
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if string(got) != string(want) {
+		t.Errorf("generate() = %s, want %s", got, want)
+	}
+	// Suit ends with an excluded iota constant, so it's protected without -allowappend
+	if _, err = generate(config{dir: filepath.Join("testdata", "cards"), types: []string{"Suit"}, exclude: "suit_enum.go"}); err != nil {
+		t.Errorf("generate() error = %v", err)
+	}
+}
+
+func TestGenerate_error(t *testing.T) {
+	dir := t.TempDir()
+	src := `package p
+
+type Point struct{ X, Y int }
+
+type Excluded int
+
+const Only Excluded = 0 // enum:"-"
+
+type Empty int
+
+type Appendable int
+
+const (
+	First Appendable = iota
+	Second
+)
+
+type Explicit string
+
+const (
+	Only1 Explicit = "1"
+	last  Explicit = "-" // enum:"-"
+)
+
+type Split int
+
+const (
+	Left Split = iota
+	numSplits // enum:"-"
+)
+
+const Right Split = 5
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	broken := t.TempDir()
+	src = `package p
+
+type Broken int
+
+const (
+	Bad Broken = unknown + iota
+	numBroken // enum:"-"
+)
+`
+	if err := os.WriteFile(filepath.Join(broken, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name    string
+		dir     string
+		typ     string
+		wantErr string
+	}{
+		{name: "no files", dir: t.TempDir(), typ: "Suit", wantErr: "no Go files"},
+		{name: "not found", dir: dir, typ: "Suit", wantErr: "type Suit not found"},
+		{name: "not const", dir: dir, typ: "Point", wantErr: "cannot have constants"},
+		{name: "no constants", dir: dir, typ: "Empty", wantErr: "no constants"},
+		{name: "all excluded", dir: dir, typ: "Excluded", wantErr: "are excluded"},
+		{name: "no sentinel", dir: dir, typ: "Appendable", wantErr: "p.go:13:1: constants of type Appendable added to this block"},
+		{name: "explicit", dir: dir, typ: "Explicit", wantErr: "p.go:20:1: constants of type Explicit added to this block"},
+		{name: "separate", dir: dir, typ: "Split", wantErr: "p.go:32:1: constants of type Split added to this block"},
+		{name: "type error", dir: broken, typ: "Broken", wantErr: "undefined: unknown"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := generate(config{dir: tt.dir, types: []string{tt.typ}})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("generate() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerate_previous(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"p.go": `package p
+
+type Suit int
+
+const (
+	Clubs Suit = iota
+	numSuits // enum:"-"
+)
+
+var names = SuitEnum.Names()
+`,
+		// stale output that refers to a removed constant
+		"suit_enum.go": `package p
+
+func (s Suit) String() string { return "" }
+
+var SuitEnum = enum.Of(two.TupleOf("Hearts", Hearts))
+`,
+	}
+	for name, src := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(src), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	got, err := generate(config{dir: dir, types: []string{"Suit"}, exclude: "suit_enum.go"})
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if !strings.Contains(string(got), `two.TupleOf("Clubs", Clubs)`) || !strings.Contains(string(got), "func (s Suit) String() string") {
+		t.Errorf("generate() = %s, want Clubs and String", got)
+	}
+}
+
+func TestGenerate_allowAppend(t *testing.T) {
+	dir := t.TempDir()
+	src := `package p
+
+type Appendable int
+
+const (
+	First Appendable = iota
+	Second
+)
+
+type Explicit string
+
+const (
+	Only1 Explicit = "1"
+	last  Explicit = "-" // enum:"-"
+)
+
+type Split int
+
+const (
+	Left Split = iota
+	numSplits // enum:"-"
+)
+
+const Right Split = 5
+`
+	if err := os.WriteFile(filepath.Join(dir, "p.go"), []byte(src), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	got, err := generate(config{dir: dir, types: []string{"Appendable"}, allowAppend: true})
+	if err != nil {
+		t.Fatalf("generate() error = %v", err)
+	}
+	if !strings.Contains(string(got), "Second == 1: {}") {
+		t.Errorf("generate() = %s, want check of Second", got)
+	}
+}
+
+func TestGenerated(t *testing.T) {
+	type hand struct {
+		Suit cards.Suit `json:"suit"`
+		Rank cards.Rank `json:"rank"`
+	}
+	b, err := json.Marshal(hand{Suit: cards.Hearts, Rank: cards.RankQueen})
+	if err != nil || string(b) != `{"suit":"♥","rank":"Queen"}` {
+		t.Errorf("json.Marshal() = %s %v", b, err)
+	}
+	var got hand
+	if err = json.Unmarshal(b, &got); err != nil || got.Suit != cards.Hearts || got.Rank != cards.RankQueen {
+		t.Errorf("json.Unmarshal() = %v %v", got, err)
+	}
+	if err = json.Unmarshal([]byte(`{"suit":"Hearts"}`), &got); err == nil {
+		t.Errorf("json.Unmarshal() error = %v, want error", err)
+	}
+	if _, err = json.Marshal(cards.Suit(9)); err == nil {
+		t.Errorf("json.Marshal() error = %v, want error", err)
+	}
+	if got := cards.Suit(9).String(); got != "Suit(9)" {
+		t.Errorf("String() = %v, want %v", got, "Suit(9)")
+	}
+	if got := cards.SuitEnum.Names(); strings.Join(got, ",") != "Clubs,Diamonds,♥,Spades" {
+		t.Errorf("Names() = %v", got)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+// Enumgen generates enum.Enum declarations from typed const blocks.
+//
+// Given a type such as
+//
+//	type Suit int
+//
+//	const (
+//		Clubs Suit = iota
+//		Diamonds
+//		Hearts // enum:"♥"
+//		Spades
+//		numSuits // enum:"-"
+//	)
+//
+// running
+//
+//	enumgen -type=Suit
+//
+// in the package directory writes suit_enum.go, which declares
+//
+//	var SuitEnum = enum.Register(enum.Of(two.TupleOf("Clubs", Clubs), ...))
+//
+// along with String, MarshalText and UnmarshalText methods for Suit
+// (unless Suit already declares them)
+// and a compile-time check that fails if the constants change without regenerating.
+//
+// The name of a constant is its identifier, less the prefix given by -trimprefix,
+// unless its doc or line comment contains a tag of the form enum:"name".
+// The tag enum:"-" excludes a constant from the enum, though it's still included in the check.
+// Each const block of the type must be an iota block that ends with such a constant,
+// so that inserting a constant changes the value of the last one and fails the check.
+// Otherwise, e.g. for a block of explicit values, added constants would go undetected,
+// so enumgen fails unless -allowappend is set.
+// Constants of the type declared in a new const block are never detected.
+//
+// Typically, enumgen is invoked by go generate:
+//
+//	//go:generate go run github.com/phelmkamp/valor/enumgen -type=Suit
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+var (
+	typeNames   = flag.String("type", "", "comma-separated list of type names; must be set")
+	output      = flag.String("output", "", "output file name; default <type>_enum.go")
+	trimPrefix  = flag.String("trimprefix", "", "prefix to remove from the identifiers of constants")
+	allowAppend = flag.Bool("allowappend", false, "allow const blocks that don't end with an iota constant tagged enum:\"-\"")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage of enumgen:\n")
+	fmt.Fprintf(os.Stderr, "\tenumgen [flags] -type T [directory]\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("enumgen: ")
+	flag.Usage = usage
+	flag.Parse()
+	if *typeNames == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+	dir := "."
+	if args := flag.Args(); len(args) == 1 {
+		dir = args[0]
+	} else if len(args) > 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	types := strings.Split(*typeNames, ",")
+	outName := *output
+	if outName == "" {
+		outName = strings.ToLower(types[0]) + "_enum.go"
+	}
+	outPath := filepath.Join(dir, outName)
+
+	src, err := generate(config{
+		dir:         dir,
+		types:       types,
+		trimPrefix:  *trimPrefix,
+		exclude:     outName,
+		allowAppend: *allowAppend,
+		args:        os.Args[1:],
+	})
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err = os.WriteFile(outPath, src, 0o644); err != nil {
+		log.Fatalf("writing output: %v", err)
+	}
+}
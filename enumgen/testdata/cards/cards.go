@@ -0,0 +1,32 @@
+// Package cards is used to test enumgen.
+package cards
+
+//go:generate go run github.com/phelmkamp/valor/enumgen -type=Suit,Rank -trimprefix=Rank -allowappend
+
+// Suit is the suit of a card.
+type Suit int
+
+const (
+	Clubs Suit = iota
+	Diamonds
+	Hearts // enum:"♥"
+	Spades
+	// numSuits detects the addition of constants.
+	// enum:"-"
+	numSuits
+)
+
+// Rank is the rank of a card.
+type Rank string
+
+const (
+	RankAce   Rank = "A"
+	RankKing  Rank = "K"
+	RankQueen Rank = "Q"
+	RankJack  Rank = "J"
+)
+
+// String returns r as a string.
+func (r Rank) String() string {
+	return string(r)
+}
@@ -0,0 +1,100 @@
+// Code generated by "enumgen -type=Suit,Rank -trimprefix=Rank -allowappend"; DO NOT EDIT.
+
+package cards
+
+import (
+	"fmt"
+
+	"github.com/phelmkamp/valor/enum"
+	"github.com/phelmkamp/valor/tuple/two"
+)
+
+func _() {
+	// A "duplicate key false" compiler error signifies that the Suit constants have changed.
+	// Re-run the enumgen command to generate them again.
+	_ = map[bool]struct{}{
+		false: {},
+		Clubs == 0 &&
+			Diamonds == 1 &&
+			Hearts == 2 &&
+			Spades == 3 &&
+			numSuits == 4: {},
+	}
+}
+
+// SuitEnum is the enum.Enum of the Suit constants.
+var SuitEnum = enum.Register(enum.Of(
+	two.TupleOf("Clubs", Clubs),
+	two.TupleOf("Diamonds", Diamonds),
+	two.TupleOf("♥", Hearts),
+	two.TupleOf("Spades", Spades),
+))
+
+// String returns the name of s.
+func (s Suit) String() string {
+	return SuitEnum.NameOf(s).OrElse(func() string {
+		return fmt.Sprintf("Suit(%v)", int(s))
+	})
+}
+
+// MarshalText returns the name of s.
+// Returns an error if s is not one of the Suit constants.
+func (s Suit) MarshalText() ([]byte, error) {
+	var name string
+	if !SuitEnum.NameOf(s).Ok(&name) {
+		return nil, fmt.Errorf("invalid Suit: %v", int(s))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText sets s to the constant with the given name.
+// Returns an *enum.InvalidNameError if text is not the name of one of the Suit constants.
+func (s *Suit) UnmarshalText(text []byte) error {
+	val, err := SuitEnum.Parse(string(text)).Unpack()
+	if err != nil {
+		return err
+	}
+	*s = val.OrZero()
+	return nil
+}
+
+func _() {
+	// A "duplicate key false" compiler error signifies that the Rank constants have changed.
+	// Re-run the enumgen command to generate them again.
+	_ = map[bool]struct{}{
+		false: {},
+		RankAce == "A" &&
+			RankKing == "K" &&
+			RankQueen == "Q" &&
+			RankJack == "J": {},
+	}
+}
+
+// RankEnum is the enum.Enum of the Rank constants.
+var RankEnum = enum.Register(enum.Of(
+	two.TupleOf("Ace", RankAce),
+	two.TupleOf("King", RankKing),
+	two.TupleOf("Queen", RankQueen),
+	two.TupleOf("Jack", RankJack),
+))
+
+// MarshalText returns the name of r.
+// Returns an error if r is not one of the Rank constants.
+func (r Rank) MarshalText() ([]byte, error) {
+	var name string
+	if !RankEnum.NameOf(r).Ok(&name) {
+		return nil, fmt.Errorf("invalid Rank: %q", string(r))
+	}
+	return []byte(name), nil
+}
+
+// UnmarshalText sets r to the constant with the given name.
+// Returns an *enum.InvalidNameError if text is not the name of one of the Rank constants.
+func (r *Rank) UnmarshalText(text []byte) error {
+	val, err := RankEnum.Parse(string(text)).Unpack()
+	if err != nil {
+		return err
+	}
+	*r = val.OrZero()
+	return nil
+}
@@ -1,3 +1,3 @@
 module github.com/phelmkamp/valor
 
-go 1.19
+go 1.24
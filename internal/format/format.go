@@ -0,0 +1,96 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+// Package format provides helpers for implementing fmt.Formatter.
+package format
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"regexp"
+	"strings"
+)
+
+// importPath matches the import path that qualifies a package name,
+// e.g. "github.com/phelmkamp/valor/" in "github.com/phelmkamp/valor/optional.Value".
+var importPath = regexp.MustCompile(`(?:[\w.\-]+/)+`)
+
+// TypeName returns the name of T as it would appear in Go source,
+// i.e. qualified by package name rather than import path.
+func TypeName[T any]() string {
+	return importPath.ReplaceAllString(reflect.TypeFor[T]().String(), "")
+}
+
+// GoSyntax reports whether s and verb represent the %#v directive.
+func GoSyntax(s fmt.State, verb rune) bool {
+	return verb == 'v' && s.Flag('#')
+}
+
+// Writer composes the text of a wrapper type, such as Some(42), for a directive.
+// The width and - flag of the directive are applied to the whole text,
+// while the other flags and precision are applied to the contained values.
+type Writer struct {
+	s      fmt.State
+	format string // directive for contained values
+	buf    strings.Builder
+}
+
+// NewWriter returns a Writer of the text for the directive represented by s and verb.
+// The caller must call Flush to write the text to s.
+func NewWriter(s fmt.State, verb rune) *Writer {
+	var b strings.Builder
+	b.WriteByte('%')
+	for _, flag := range "+# " {
+		if s.Flag(int(flag)) {
+			b.WriteRune(flag)
+		}
+	}
+	if prec, ok := s.Precision(); ok {
+		fmt.Fprintf(&b, ".%d", prec)
+	}
+	b.WriteRune(verb)
+	return &Writer{s: s, format: b.String()}
+}
+
+// WriteString writes the literal text str.
+func (w *Writer) WriteString(str string) (int, error) {
+	return w.buf.WriteString(str)
+}
+
+// Inner writes the contained value v using the flags and precision of the directive.
+func (w *Writer) Inner(v any) {
+	fmt.Fprintf(&w.buf, w.format, v)
+}
+
+// Flush writes the text to the fmt.State, padded to the width of the directive.
+func (w *Writer) Flush() {
+	width, ok := w.s.Width()
+	switch {
+	case !ok:
+		io.WriteString(w.s, w.buf.String())
+	case w.s.Flag('-'):
+		fmt.Fprintf(w.s, "%-*s", width, w.buf.String())
+	default:
+		fmt.Fprintf(w.s, "%*s", width, w.buf.String())
+	}
+}
+
+// Bare formats a contained value alone, e.g. 42, or <none> if there is none.
+type Bare struct {
+	V  any  // the contained value
+	Ok bool // whether there is a contained value
+}
+
+// Format implements the fmt.Formatter interface.
+// The directive is applied to the contained value.
+func (b Bare) Format(s fmt.State, verb rune) {
+	w := NewWriter(s, verb)
+	if b.Ok {
+		w.Inner(b.V)
+	} else {
+		w.WriteString("<none>")
+	}
+	w.Flush()
+}
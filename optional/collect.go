@@ -0,0 +1,29 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+// Collect returns a Value of the underlying values of vals.
+// Returns a not-ok Value if any of vals is not ok.
+func Collect[T any](vals []Value[T]) Value[[]T] {
+	s := make([]T, 0, len(vals))
+	for _, val := range vals {
+		if !val.IsOk() {
+			return OfNotOk[[]T]()
+		}
+		s = append(s, val.v)
+	}
+	return OfOk(s)
+}
+
+// Compact returns the underlying values of vals that are ok.
+func Compact[T any](vals []Value[T]) []T {
+	var s []T
+	for _, val := range vals {
+		if val.IsOk() {
+			s = append(s, val.v)
+		}
+	}
+	return s
+}
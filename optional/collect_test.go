@@ -0,0 +1,53 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name string
+		vals []optional.Value[int]
+		want optional.Value[[]int]
+	}{
+		{
+			name: "empty",
+			vals: nil,
+			want: optional.OfOk([]int{}),
+		},
+		{
+			name: "ok",
+			vals: []optional.Value[int]{optional.OfOk(1), optional.OfOk(2)},
+			want: optional.OfOk([]int{1, 2}),
+		},
+		{
+			name: "not ok",
+			vals: []optional.Value[int]{optional.OfOk(1), optional.OfNotOk[int]()},
+			want: optional.OfNotOk[[]int](),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := optional.Collect(tt.vals); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Collect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCompact(t *testing.T) {
+	vals := []optional.Value[string]{optional.OfOk("foo"), optional.OfNotOk[string](), optional.OfOk("")}
+	if got, want := optional.Compact(vals), []string{"foo", ""}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Compact() = %v, want %v", got, want)
+	}
+	if got := optional.Compact([]optional.Value[string]{optional.OfNotOk[string]()}); len(got) != 0 {
+		t.Errorf("Compact() = %v, want %v", got, []string{})
+	}
+}
@@ -0,0 +1,66 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+import "cmp"
+
+// Equal returns whether val and val2 are both not ok or both ok with equal underlying values.
+func Equal[T comparable](val, val2 Value[T]) bool {
+	return EqualFunc(val, val2, func(v, v2 T) bool { return v == v2 })
+}
+
+// EqualFunc is like Equal but uses eq to compare the underlying values.
+// eq is only called if both val and val2 are ok.
+func EqualFunc[T, T2 any](val Value[T], val2 Value[T2], eq func(T, T2) bool) bool {
+	if !val.IsOk() || !val2.IsOk() {
+		return val.IsOk() == val2.IsOk()
+	}
+	return eq(val.v, val2.v)
+}
+
+// Compare returns -1 if val is less than val2, 0 if they're equal, and +1 if val is greater than val2.
+// A not-ok Value is less than any ok Value; two not-ok Values are equal.
+// Otherwise, the underlying values are compared with cmp.Compare.
+func Compare[T cmp.Ordered](val, val2 Value[T]) int {
+	return CompareFunc(val, val2, cmp.Compare[T])
+}
+
+// CompareFunc is like Compare but uses cmp to compare the underlying values.
+// cmp is only called if both val and val2 are ok.
+func CompareFunc[T, T2 any](val Value[T], val2 Value[T2], cmp func(T, T2) int) int {
+	switch {
+	case !val.IsOk() && !val2.IsOk():
+		return 0
+	case !val.IsOk():
+		return -1
+	case !val2.IsOk():
+		return +1
+	}
+	return cmp(val.v, val2.v)
+}
+
+// NotOkLast returns a comparison function like cmp except that a not-ok Value is greater than any ok Value.
+// This is useful for sorting not-ok Values last:
+//
+//	slices.SortFunc(s, optional.NotOkLast(optional.Compare[int]))
+func NotOkLast[T any](cmp func(Value[T], Value[T]) int) func(Value[T], Value[T]) int {
+	return func(val, val2 Value[T]) int {
+		if val.IsOk() != val2.IsOk() {
+			if val.IsOk() {
+				return -1
+			}
+			return +1
+		}
+		return cmp(val, val2)
+	}
+}
+
+// Less returns whether val is less than val2 according to Compare.
+// This is useful with sort.Slice:
+//
+//	sort.Slice(s, func(i, j int) bool { return optional.Less(s[i], s[j]) })
+func Less[T cmp.Ordered](val, val2 Value[T]) bool {
+	return Compare(val, val2) < 0
+}
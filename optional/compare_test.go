@@ -0,0 +1,99 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+func ExampleCompare() {
+	s := []optional.Value[int]{optional.OfOk(2), optional.OfNotOk[int](), optional.OfOk(1)}
+	slices.SortFunc(s, optional.Compare[int])
+	fmt.Println(optional.Compact(s), s[0].IsOk())
+	slices.SortFunc(s, optional.NotOkLast(optional.Compare[int]))
+	fmt.Println(optional.Compact(s), s[2].IsOk())
+	// Output:
+	// [1 2] false
+	// [1 2] false
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		val  optional.Value[int]
+		val2 optional.Value[int]
+		want bool
+	}{
+		{name: "both not ok", val: optional.OfNotOk[int](), val2: optional.OfNotOk[int](), want: true},
+		{name: "not ok", val: optional.OfNotOk[int](), val2: optional.OfOk(0), want: false},
+		{name: "not ok 2", val: optional.OfOk(0), val2: optional.OfNotOk[int](), want: false},
+		{name: "equal", val: optional.OfOk(1), val2: optional.OfOk(1), want: true},
+		{name: "not equal", val: optional.OfOk(1), val2: optional.OfOk(2), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := optional.Equal(tt.val, tt.val2); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	if !optional.EqualFunc(optional.OfOk([]int{1}), optional.OfOk([]int{1}), slices.Equal[[]int]) {
+		t.Errorf("EqualFunc() = %v, want %v", false, true)
+	}
+	if !optional.EqualFunc(optional.OfOk("foo"), optional.OfOk("FOO"), strings.EqualFold) {
+		t.Errorf("EqualFunc() = %v, want %v", false, true)
+	}
+	if optional.EqualFunc(optional.OfNotOk[string](), optional.OfOk("foo"), strings.EqualFold) {
+		t.Errorf("EqualFunc() = %v, want %v", true, false)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name        string
+		val         optional.Value[string]
+		val2        optional.Value[string]
+		want        int
+		wantNotLast int
+	}{
+		{name: "both not ok", val: optional.OfNotOk[string](), val2: optional.OfNotOk[string](), want: 0, wantNotLast: 0},
+		{name: "not ok", val: optional.OfNotOk[string](), val2: optional.OfOk(""), want: -1, wantNotLast: +1},
+		{name: "not ok 2", val: optional.OfOk(""), val2: optional.OfNotOk[string](), want: +1, wantNotLast: -1},
+		{name: "less", val: optional.OfOk("a"), val2: optional.OfOk("b"), want: -1, wantNotLast: -1},
+		{name: "equal", val: optional.OfOk("a"), val2: optional.OfOk("a"), want: 0, wantNotLast: 0},
+		{name: "greater", val: optional.OfOk("b"), val2: optional.OfOk("a"), want: +1, wantNotLast: +1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := optional.Compare(tt.val, tt.val2); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+			if got := optional.Less(tt.val, tt.val2); got != (tt.want < 0) {
+				t.Errorf("Less() = %v, want %v", got, tt.want < 0)
+			}
+			if got := optional.NotOkLast(optional.Compare[string])(tt.val, tt.val2); got != tt.wantNotLast {
+				t.Errorf("NotOkLast() = %v, want %v", got, tt.wantNotLast)
+			}
+		})
+	}
+}
+
+func TestLess(t *testing.T) {
+	s := []optional.Value[float64]{optional.OfOk(2.0), optional.OfNotOk[float64](), optional.OfOk(-1.0)}
+	sort.Slice(s, func(i, j int) bool { return optional.Less(s[i], s[j]) })
+	want := []optional.Value[float64]{optional.OfNotOk[float64](), optional.OfOk(-1.0), optional.OfOk(2.0)}
+	if !slices.Equal(s, want) {
+		t.Errorf("sorted = %v, want %v", s, want)
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/xml"
+	"fmt"
+	"reflect"
+	"strconv"
+)
+
+// MarshalText encodes val as text.
+// Marshals the underlying value if ok, returns nil if not ok.
+//
+// Text can't distinguish a not-ok Value from one whose underlying value marshals to empty text,
+// such as OfOk(""): both are unmarshaled by UnmarshalText as not ok.
+// Use JSON if that distinction matters.
+//
+// The underlying value is marshaled with its MarshalText method if present.
+// Otherwise, it must be a boolean, numeric, or string type.
+func (val Value[T]) MarshalText() (text []byte, err error) {
+	if !val.IsOk() {
+		return nil, nil
+	}
+	return marshalText(val.v)
+}
+
+// UnmarshalText decodes text into val.
+// val will be ok if the underlying value was unmarshaled successfully.
+// Sets val to not-ok if text is empty, even if the underlying type can represent empty text (see MarshalText).
+//
+// The underlying value is unmarshaled with its UnmarshalText method if present.
+// Otherwise, it must be a boolean, numeric, or string type.
+func (val *Value[T]) UnmarshalText(text []byte) error {
+	if len(text) == 0 {
+		*val = OfNotOk[T]()
+		return nil
+	}
+	// unmarshal into temp first in case of error
+	var temp T
+	if err := unmarshalText(text, &temp); err != nil {
+		return err
+	}
+	val.v, val.ok = temp, true
+	return nil
+}
+
+// MarshalXML encodes val as an XML element.
+// Marshals the underlying value if ok, omits the element if not ok.
+func (val Value[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	if !val.IsOk() {
+		return nil
+	}
+	return e.EncodeElement(val.v, start)
+}
+
+// UnmarshalXML decodes an XML element into val.
+// val will be ok if the underlying value was unmarshaled successfully.
+func (val *Value[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	// unmarshal into temp first in case of error
+	var temp T
+	if err := d.DecodeElement(&temp, &start); err != nil {
+		return err
+	}
+	val.v, val.ok = temp, true
+	return nil
+}
+
+// xmlAttr is used to marshal an attribute according to the rules of encoding/xml.
+type xmlAttr[T any] struct {
+	XMLName xml.Name `xml:"a"`
+	V       T        `xml:"v,attr"`
+}
+
+// MarshalXMLAttr encodes val as an XML attribute.
+// Marshals the underlying value if ok, omits the attribute if not ok.
+func (val Value[T]) MarshalXMLAttr(name xml.Name) (xml.Attr, error) {
+	if !val.IsOk() {
+		return xml.Attr{}, nil
+	}
+	b, err := xml.Marshal(xmlAttr[T]{V: val.v})
+	if err != nil {
+		return xml.Attr{}, err
+	}
+	var s xmlAttr[string]
+	if err = xml.Unmarshal(b, &s); err != nil {
+		return xml.Attr{}, err
+	}
+	return xml.Attr{Name: name, Value: s.V}, nil
+}
+
+// UnmarshalXMLAttr decodes an XML attribute into val.
+// val will be ok if the underlying value was unmarshaled successfully.
+func (val *Value[T]) UnmarshalXMLAttr(attr xml.Attr) error {
+	var buf bytes.Buffer
+	buf.WriteString(`<a v="`)
+	if err := xml.EscapeText(&buf, []byte(attr.Value)); err != nil {
+		return err
+	}
+	buf.WriteString(`"/>`)
+	// unmarshal into temp first in case of error
+	var temp xmlAttr[T]
+	if err := xml.Unmarshal(buf.Bytes(), &temp); err != nil {
+		return err
+	}
+	val.v, val.ok = temp.V, true
+	return nil
+}
+
+// GobEncode encodes val as gob.
+// Encodes whether val is ok followed by the underlying value if ok.
+func (val Value[T]) GobEncode() ([]byte, error) {
+	var buf bytes.Buffer
+	enc := gob.NewEncoder(&buf)
+	if err := enc.Encode(val.ok); err != nil {
+		return nil, err
+	}
+	if val.ok {
+		if err := enc.Encode(val.v); err != nil {
+			return nil, err
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// GobDecode decodes gob data into val.
+// val will be ok if the data contains an underlying value that was decoded successfully.
+func (val *Value[T]) GobDecode(data []byte) error {
+	dec := gob.NewDecoder(bytes.NewReader(data))
+	var ok bool
+	if err := dec.Decode(&ok); err != nil {
+		return err
+	}
+	if !ok {
+		*val = OfNotOk[T]()
+		return nil
+	}
+	// decode into temp first in case of error
+	var temp T
+	if err := dec.Decode(&temp); err != nil {
+		return err
+	}
+	val.v, val.ok = temp, true
+	return nil
+}
+
+// marshalText encodes v with its MarshalText method or as a boolean, numeric, or string kind.
+func marshalText(v any) ([]byte, error) {
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		return m.MarshalText()
+	}
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.String:
+		return []byte(rv.String()), nil
+	case reflect.Bool:
+		return strconv.AppendBool(nil, rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.AppendInt(nil, rv.Int(), 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.AppendUint(nil, rv.Uint(), 10), nil
+	case reflect.Float32, reflect.Float64:
+		return strconv.AppendFloat(nil, rv.Float(), 'g', -1, rv.Type().Bits()), nil
+	}
+	return nil, fmt.Errorf("optional: cannot marshal %T as text", v)
+}
+
+// unmarshalText decodes text into the value pointed to by p
+// with its UnmarshalText method or as a boolean, numeric, or string kind.
+func unmarshalText(text []byte, p any) error {
+	if u, ok := p.(encoding.TextUnmarshaler); ok {
+		return u.UnmarshalText(text)
+	}
+	rv := reflect.ValueOf(p).Elem()
+	s := string(text)
+	switch rv.Kind() {
+	case reflect.String:
+		rv.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		rv.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetInt(i)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u, err := strconv.ParseUint(s, 10, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetUint(u)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, rv.Type().Bits())
+		if err != nil {
+			return err
+		}
+		rv.SetFloat(f)
+		return nil
+	}
+	return fmt.Errorf("optional: cannot unmarshal text into %s", rv.Type())
+}
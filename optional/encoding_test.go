@@ -0,0 +1,327 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"encoding/xml"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+// type checks
+var (
+	_ encoding.TextMarshaler   = optional.Value[int]{}
+	_ encoding.TextUnmarshaler = &optional.Value[int]{}
+	_ xml.Marshaler            = optional.Value[int]{}
+	_ xml.Unmarshaler          = &optional.Value[int]{}
+	_ xml.MarshalerAttr        = optional.Value[int]{}
+	_ xml.UnmarshalerAttr      = &optional.Value[int]{}
+	_ gob.GobEncoder           = optional.Value[int]{}
+	_ gob.GobDecoder           = &optional.Value[int]{}
+)
+
+// Example_xml demonstrates that a Value can be marshaled to and unmarshaled from XML.
+func Example_xml() {
+	type Obj struct {
+		ID  optional.Value[int]    `xml:"id,attr"`
+		Val optional.Value[string] `xml:"val"`
+	}
+	b, _ := xml.Marshal(Obj{ID: optional.OfOk(1), Val: optional.OfOk("foo")})
+	fmt.Println(string(b))
+	var obj Obj
+	_ = xml.Unmarshal(b, &obj)
+	fmt.Println(obj.ID.MustOk(), obj.Val.MustOk())
+
+	b, _ = xml.Marshal(Obj{})
+	fmt.Println(string(b))
+	obj = Obj{}
+	_ = xml.Unmarshal(b, &obj)
+	fmt.Println(obj.ID.IsOk(), obj.Val.IsOk())
+	// Output:
+	// <Obj id="1"><val>foo</val></Obj>
+	// 1 foo
+	// <Obj></Obj>
+	// false false
+}
+
+func TestValue_MarshalText(t *testing.T) {
+	type myFloat float32
+	tests := []struct {
+		name    string
+		val     encoding.TextMarshaler
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "not ok",
+			val:  optional.OfNotOk[int](),
+			want: "",
+		},
+		{
+			name: "string",
+			val:  optional.OfOk("foo"),
+			want: "foo",
+		},
+		{
+			name: "bool",
+			val:  optional.OfOk(true),
+			want: "true",
+		},
+		{
+			name: "int",
+			val:  optional.OfOk(-42),
+			want: "-42",
+		},
+		{
+			name: "uint",
+			val:  optional.OfOk(uint8(42)),
+			want: "42",
+		},
+		{
+			name: "float",
+			val:  optional.OfOk(myFloat(1.5)),
+			want: "1.5",
+		},
+		{
+			name: "TextMarshaler",
+			val:  optional.OfOk(time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)),
+			want: "1970-01-01T00:00:00Z",
+		},
+		{
+			name:    "unsupported",
+			val:     optional.OfOk([]int{1}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.val.MarshalText()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalText() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_UnmarshalText(t *testing.T) {
+	tests := []struct {
+		name    string
+		text    string
+		want    optional.Value[int]
+		wantErr bool
+	}{
+		{
+			name: "empty",
+			text: "",
+			want: optional.OfNotOk[int](),
+		},
+		{
+			name: "int",
+			text: "42",
+			want: optional.OfOk(42),
+		},
+		{
+			name:    "invalid",
+			text:    "foo",
+			want:    optional.OfOk(-1),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val := optional.OfOk(-1)
+			if err := val.UnmarshalText([]byte(tt.text)); (err != nil) != tt.wantErr {
+				t.Errorf("UnmarshalText() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if val != tt.want {
+				t.Errorf("val after UnmarshalText() = %v, want %v", val, tt.want)
+			}
+		})
+	}
+
+	// round trip
+	for _, want := range []optional.Value[time.Time]{
+		optional.OfNotOk[time.Time](),
+		optional.OfOk(time.Date(1970, time.January, 1, 0, 0, 0, 0, time.UTC)),
+	} {
+		text, err := want.MarshalText()
+		if err != nil {
+			t.Fatalf("MarshalText() error = %v", err)
+		}
+		var got optional.Value[time.Time]
+		if err = got.UnmarshalText(text); err != nil {
+			t.Errorf("UnmarshalText() error = %v, want %v", err, nil)
+		}
+		if got != want {
+			t.Errorf("val after UnmarshalText() = %v, want %v", got, want)
+		}
+	}
+
+	// an ok empty string doesn't round-trip
+	text, err := optional.OfOk("").MarshalText()
+	if err != nil || len(text) != 0 {
+		t.Fatalf("MarshalText() = %q %v, want empty", text, err)
+	}
+	got := optional.OfOk("foo")
+	if err = got.UnmarshalText(text); err != nil || got != optional.OfNotOk[string]() {
+		t.Errorf("val after UnmarshalText() = %v %v, want %v %v", got, err, optional.OfNotOk[string](), nil)
+	}
+}
+
+func TestValue_XML(t *testing.T) {
+	type obj struct {
+		XMLName xml.Name                `xml:"obj"`
+		Attr    optional.Value[float64] `xml:"attr,attr"`
+		Str     optional.Value[string]  `xml:"str"`
+		Time    optional.Value[time.Time]
+	}
+	tests := []struct {
+		name string
+		obj  obj
+		want string
+	}{
+		{
+			name: "not ok",
+			obj:  obj{},
+			want: `<obj></obj>`,
+		},
+		{
+			name: "zero",
+			obj: obj{
+				Attr: optional.OfOk(0.0),
+				Str:  optional.OfOk(""),
+				Time: optional.OfOk(time.Time{}),
+			},
+			want: `<obj attr="0"><str></str><Time>0001-01-01T00:00:00Z</Time></obj>`,
+		},
+		{
+			name: "escaped",
+			obj: obj{
+				Attr: optional.OfOk(1.5),
+				Str:  optional.OfOk(`<"foo">`),
+			},
+			want: `<obj attr="1.5"><str>&lt;&#34;foo&#34;&gt;</str></obj>`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b, err := xml.Marshal(tt.obj)
+			if err != nil {
+				t.Fatalf("xml.Marshal() error = %v", err)
+			}
+			if string(b) != tt.want {
+				t.Errorf("xml.Marshal() = %s, want %s", b, tt.want)
+			}
+			var got obj
+			if err = xml.Unmarshal(b, &got); err != nil {
+				t.Fatalf("xml.Unmarshal() error = %v", err)
+			}
+			got.XMLName = xml.Name{}
+			if got != tt.obj {
+				t.Errorf("obj after xml.Unmarshal() = %v, want %v", got, tt.obj)
+			}
+		})
+	}
+
+	// attribute with special characters
+	var got optional.Value[string]
+	if err := got.UnmarshalXMLAttr(xml.Attr{Value: `<"foo" & 'bar'>`}); err != nil {
+		t.Errorf("UnmarshalXMLAttr() error = %v, want %v", err, nil)
+	}
+	if got != optional.OfOk(`<"foo" & 'bar'>`) {
+		t.Errorf("val after UnmarshalXMLAttr() = %v, want %v", got, optional.OfOk(`<"foo" & 'bar'>`))
+	}
+	attr, err := got.MarshalXMLAttr(xml.Name{Local: "foo"})
+	if err != nil {
+		t.Errorf("MarshalXMLAttr() error = %v, want %v", err, nil)
+	}
+	if want := (xml.Attr{Name: xml.Name{Local: "foo"}, Value: `<"foo" & 'bar'>`}); attr != want {
+		t.Errorf("MarshalXMLAttr() = %v, want %v", attr, want)
+	}
+
+	// invalid
+	val := optional.OfOk(-1)
+	if err = val.UnmarshalXMLAttr(xml.Attr{Value: "foo"}); err == nil {
+		t.Errorf("UnmarshalXMLAttr() error = %v, want error", err)
+	}
+	if val != optional.OfOk(-1) {
+		t.Errorf("val after UnmarshalXMLAttr() = %v, want %v", val, optional.OfOk(-1))
+	}
+}
+
+func TestValue_Gob(t *testing.T) {
+	type obj struct {
+		Name string
+		Val  optional.Value[int]
+		Ptr  optional.Value[*string]
+	}
+	s := "foo"
+	tests := []struct {
+		name string
+		obj  obj
+	}{
+		{
+			name: "not ok",
+			obj:  obj{Name: "foo"},
+		},
+		{
+			name: "zero",
+			obj:  obj{Name: "foo", Val: optional.OfOk(0)},
+		},
+		{
+			name: "ok",
+			obj:  obj{Name: "foo", Val: optional.OfOk(42), Ptr: optional.OfOk(&s)},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			if err := gob.NewEncoder(&buf).Encode(tt.obj); err != nil {
+				t.Fatalf("Encode() error = %v", err)
+			}
+			var got obj
+			if err := gob.NewDecoder(&buf).Decode(&got); err != nil {
+				t.Fatalf("Decode() error = %v", err)
+			}
+			if got.Name != tt.obj.Name || got.Val != tt.obj.Val {
+				t.Errorf("obj after Decode() = %v, want %v", got, tt.obj)
+			}
+			if gotPtr, wantPtr := optional.Map(got.Ptr, func(p *string) string { return *p }), optional.Map(tt.obj.Ptr, func(p *string) string { return *p }); gotPtr != wantPtr {
+				t.Errorf("obj.Ptr after Decode() = %v, want %v", gotPtr, wantPtr)
+			}
+		})
+	}
+}
+
+func TestValue_GobDecode(t *testing.T) {
+	data, err := optional.OfNotOk[int]().GobEncode()
+	if err != nil {
+		t.Fatalf("GobEncode() error = %v", err)
+	}
+	val := optional.OfOk(-1)
+	if err = val.GobDecode(data); err != nil {
+		t.Errorf("GobDecode() error = %v, want %v", err, nil)
+	}
+	if val != optional.OfNotOk[int]() {
+		t.Errorf("val after GobDecode() = %v, want %v", val, optional.OfNotOk[int]())
+	}
+
+	val = optional.OfOk(-1)
+	if err = val.GobDecode([]byte("foo")); err == nil {
+		t.Errorf("GobDecode() error = %v, want error", err)
+	}
+	if val != optional.OfOk(-1) {
+		t.Errorf("val after GobDecode() = %v, want %v", val, optional.OfOk(-1))
+	}
+}
@@ -0,0 +1,50 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/phelmkamp/valor/internal/format"
+)
+
+// Format implements the fmt.Formatter interface.
+//
+// The %v verb formats val as Some(v) or None, e.g. Some(42).
+// Other verbs, flags and precision, including %+v, are applied to the underlying value,
+// while width and the - flag are applied to the whole text.
+// The %#v verb formats val as Go syntax (see GoString).
+// Use Bare to format the underlying value alone.
+func (val Value[T]) Format(s fmt.State, verb rune) {
+	if format.GoSyntax(s, verb) {
+		io.WriteString(s, val.GoString())
+		return
+	}
+	w := format.NewWriter(s, verb)
+	if val.IsOk() {
+		w.WriteString("Some(")
+		w.Inner(val.v)
+		w.WriteString(")")
+	} else {
+		w.WriteString("None")
+	}
+	w.Flush()
+}
+
+// Bare returns a fmt.Formatter that formats the underlying value alone if ok, e.g. 42,
+// and <none> if not ok.
+func (val Value[T]) Bare() fmt.Formatter {
+	return format.Bare{V: val.v, Ok: val.IsOk()}
+}
+
+// GoString implements the fmt.GoStringer interface.
+// Returns the constructor call that creates val, e.g. optional.OfOk[int](42).
+func (val Value[T]) GoString() string {
+	if !val.IsOk() {
+		return fmt.Sprintf("optional.OfNotOk[%s]()", format.TypeName[T]())
+	}
+	return fmt.Sprintf("optional.OfOk[%s](%#v)", format.TypeName[T](), val.v)
+}
@@ -0,0 +1,85 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+// type checks
+var (
+	_ fmt.Formatter  = optional.Value[int]{}
+	_ fmt.GoStringer = optional.Value[int]{}
+)
+
+func ExampleValue_Bare() {
+	val := optional.OfOk(42)
+	fmt.Println(val, optional.OfNotOk[int]())
+	fmt.Println(val.Bare(), optional.OfNotOk[int]().Bare())
+	// Output:
+	// Some(42) None
+	// 42 <none>
+}
+
+func TestValue_Format(t *testing.T) {
+	type point struct{ X, Y int }
+	tests := []struct {
+		name   string
+		format string
+		val    any
+		want   string
+	}{
+		{name: "v ok", format: "%v", val: optional.OfOk(42), want: "Some(42)"},
+		{name: "v not ok", format: "%v", val: optional.OfNotOk[int](), want: "None"},
+		{name: "s", format: "%s", val: optional.OfOk("foo"), want: "Some(foo)"},
+		{name: "q", format: "%q", val: optional.OfOk("foo"), want: `Some("foo")`},
+		{name: "width", format: "%10v", val: optional.OfOk(42), want: "  Some(42)"},
+		{name: "left", format: "%-10v|", val: optional.OfOk(42), want: "Some(42)  |"},
+		{name: "width not ok", format: "%6v", val: optional.OfNotOk[int](), want: "  None"},
+		{name: "precision", format: "%.2f", val: optional.OfOk(1.5), want: "Some(1.50)"},
+		{name: "plus", format: "%+v", val: optional.OfOk(point{1, 2}), want: "Some({X:1 Y:2})"},
+		{name: "nested", format: "%v", val: optional.OfOk(optional.OfNotOk[int]()), want: "Some(None)"},
+		{name: "go ok", format: "%#v", val: optional.OfOk(42), want: "optional.OfOk[int](42)"},
+		{name: "go not ok", format: "%#v", val: optional.OfNotOk[string](), want: "optional.OfNotOk[string]()"},
+		{
+			name:   "go nested",
+			format: "%#v",
+			val:    optional.OfOk(optional.OfOk("foo")),
+			want:   `optional.OfOk[optional.Value[string]](optional.OfOk[string]("foo"))`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.val); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValue_Format_bare(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		val    any
+		want   string
+	}{
+		{name: "ok", format: "%v", val: optional.OfOk("foo").Bare(), want: "foo"},
+		{name: "not ok", format: "%v", val: optional.OfNotOk[string]().Bare(), want: "<none>"},
+		{name: "q", format: "%q", val: optional.OfOk("foo").Bare(), want: `"foo"`},
+		{name: "width", format: "%-4v|", val: optional.OfOk(1).Bare(), want: "1   |"},
+		{name: "width not ok", format: "%8v", val: optional.OfNotOk[int]().Bare(), want: "  <none>"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.val); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
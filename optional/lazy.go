@@ -0,0 +1,66 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+import "sync"
+
+// Lazy is a Value that is evaluated on first use.
+//
+// The underlying function is called at most once, even if Lazy is copied or used concurrently.
+// The zero Lazy is not ok.
+type Lazy[T any] struct {
+	force func() Value[T]
+}
+
+// LazyOf creates a Lazy of the return values of f.
+// This aids interoperability with functions that follow the "comma ok" idiom.
+// f is not called until the Lazy is used.
+func LazyOf[T any](f func() (T, bool)) Lazy[T] {
+	return Lazy[T]{force: sync.OnceValue(func() Value[T] {
+		return Of(f())
+	})}
+}
+
+// Force evaluates l if it has not been evaluated yet and returns the resulting Value.
+func (l Lazy[T]) Force() Value[T] {
+	if l.force == nil {
+		return OfNotOk[T]()
+	}
+	return l.force()
+}
+
+// IsOk evaluates l and returns whether it contains a value.
+func (l Lazy[T]) IsOk() bool {
+	return l.Force().IsOk()
+}
+
+// Ok evaluates l and sets dst to the underlying value if ok.
+// Returns true if ok, false if not ok.
+func (l Lazy[T]) Ok(dst *T) bool {
+	return l.Force().Ok(dst)
+}
+
+// Or evaluates l and returns the underlying value if ok, or def if not ok.
+func (l Lazy[T]) Or(def T) T {
+	return l.Force().Or(def)
+}
+
+// OrZero evaluates l and returns the underlying value if ok, or the zero value if not ok.
+func (l Lazy[T]) OrZero() T {
+	return l.Force().OrZero()
+}
+
+// OrElse evaluates l and returns the underlying value if ok, or the result of f if not ok.
+func (l Lazy[T]) OrElse(f func() T) T {
+	return l.Force().OrElse(f)
+}
+
+// MapLazy returns a Lazy of the result of f on the underlying value of l.
+// Neither l nor f is evaluated until the returned Lazy is used.
+func MapLazy[T, T2 any](l Lazy[T], f func(T) T2) Lazy[T2] {
+	return LazyOf(func() (T2, bool) {
+		return Map(l.Force(), f).Unpack()
+	})
+}
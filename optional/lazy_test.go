@@ -0,0 +1,126 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+func ExampleLazyOf() {
+	home := optional.LazyOf(func() (string, bool) {
+		fmt.Println("lookup")
+		return os.LookupEnv("VALOR_EXAMPLE_HOME")
+	})
+	fmt.Println("start")
+	fmt.Println(home.Or("/tmp"))
+	fmt.Println(home.IsOk())
+	// Output:
+	// start
+	// lookup
+	// /tmp
+	// false
+}
+
+// counter returns a function that counts its calls and returns the count if it's odd.
+func counter(n *atomic.Int32) func() (int, bool) {
+	return func() (int, bool) {
+		i := int(n.Add(1))
+		return i, i%2 == 1
+	}
+}
+
+func TestLazyOf(t *testing.T) {
+	var n atomic.Int32
+	l := optional.LazyOf(counter(&n))
+	if n.Load() != 0 {
+		t.Errorf("calls after LazyOf() = %v, want %v", n.Load(), 0)
+	}
+	l2 := l // copies share evaluation
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := l2.Force(); got != optional.OfOk(1) {
+				t.Errorf("Force() = %v, want %v", got, optional.OfOk(1))
+			}
+		}()
+	}
+	wg.Wait()
+	var got int
+	if !l.Ok(&got) || got != 1 {
+		t.Errorf("Ok() = %v, want %v", got, 1)
+	}
+	if n.Load() != 1 {
+		t.Errorf("calls after Force() = %v, want %v", n.Load(), 1)
+	}
+}
+
+func TestLazy(t *testing.T) {
+	notOk := optional.LazyOf(func() (int, bool) { return 1, false })
+	ok := optional.LazyOf(func() (int, bool) { return 1, true })
+	var zero optional.Lazy[int]
+	tests := []struct {
+		name       string
+		l          optional.Lazy[int]
+		wantOk     bool
+		wantOr     int
+		wantOrZero int
+		wantOrElse int
+	}{
+		{name: "zero", l: zero, wantOr: -1, wantOrElse: -2},
+		{name: "not ok", l: notOk, wantOr: -1, wantOrElse: -2},
+		{name: "ok", l: ok, wantOk: true, wantOr: 1, wantOrZero: 1, wantOrElse: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.l.IsOk(); got != tt.wantOk {
+				t.Errorf("IsOk() = %v, want %v", got, tt.wantOk)
+			}
+			if got := tt.l.Or(-1); got != tt.wantOr {
+				t.Errorf("Or() = %v, want %v", got, tt.wantOr)
+			}
+			if got := tt.l.OrZero(); got != tt.wantOrZero {
+				t.Errorf("OrZero() = %v, want %v", got, tt.wantOrZero)
+			}
+			if got := tt.l.OrElse(func() int { return -2 }); got != tt.wantOrElse {
+				t.Errorf("OrElse() = %v, want %v", got, tt.wantOrElse)
+			}
+		})
+	}
+}
+
+func TestMapLazy(t *testing.T) {
+	var n atomic.Int32
+	l := optional.LazyOf(counter(&n))
+	var mapped int
+	l2 := optional.MapLazy(l, func(i int) string {
+		mapped++
+		return strconv.Itoa(i)
+	})
+	if n.Load() != 0 || mapped != 0 {
+		t.Errorf("calls after MapLazy() = %v %v, want %v %v", n.Load(), mapped, 0, 0)
+	}
+	for i := 0; i < 2; i++ {
+		if got := l2.Force(); got != optional.OfOk("1") {
+			t.Errorf("Force() = %v, want %v", got, optional.OfOk("1"))
+		}
+	}
+	if n.Load() != 1 || mapped != 1 {
+		t.Errorf("calls after Force() = %v %v, want %v %v", n.Load(), mapped, 1, 1)
+	}
+
+	notOk := optional.MapLazy(optional.Lazy[int]{}, strconv.Itoa)
+	if got := notOk.Force(); got != optional.OfNotOk[string]() {
+		t.Errorf("Force() = %v, want %v", got, optional.OfNotOk[string]())
+	}
+}
@@ -0,0 +1,17 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+import "log/slog"
+
+// LogValue implements the slog.LogValuer interface.
+// Returns the underlying value if ok.
+// Returns an empty group if not ok, which causes handlers to omit the attribute.
+func (val Value[T]) LogValue() slog.Value {
+	if !val.IsOk() {
+		return slog.GroupValue()
+	}
+	return slog.AnyValue(val.v)
+}
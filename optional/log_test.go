@@ -0,0 +1,44 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+// type checks
+var _ slog.LogValuer = optional.Value[int]{}
+
+// removeTime removes the time attribute so that output is deterministic.
+func removeTime(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return a
+}
+
+func ExampleValue_LogValue() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+	logger.Info("user", "name", optional.OfOk("foo"), "email", optional.OfNotOk[string]())
+	// Output: {"level":"INFO","msg":"user","name":"foo"}
+}
+
+func TestValue_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+	logger.Info("msg",
+		"ok", optional.OfOk(0),
+		"not_ok", optional.OfNotOk[int](),
+		"nested", optional.OfOk(optional.OfOk("foo")),
+	)
+	if got, want := buf.String(), "level=INFO msg=msg ok=0 nested=foo\n"; got != want {
+		t.Errorf("log output = %q, want %q", got, want)
+	}
+}
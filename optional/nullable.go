@@ -0,0 +1,137 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Nullable either contains a value (present), null, or nothing at all (absent).
+//
+// This distinguishes a JSON field that was omitted from one that was explicitly set to null.
+// The zero value is absent.
+type Nullable[T any] struct {
+	val Value[T]
+	set bool // true if present or null
+}
+
+// OfAbsent creates a Nullable that is absent.
+// This aids in comparisons, enabling the use of Nullable in switch statements.
+func OfAbsent[T any]() Nullable[T] {
+	return Nullable[T]{}
+}
+
+// OfNull creates a Nullable that is null.
+// This aids in comparisons, enabling the use of Nullable in switch statements.
+func OfNull[T any]() Nullable[T] {
+	return Nullable[T]{set: true}
+}
+
+// OfPresent creates a Nullable of v that is present.
+func OfPresent[T any](v T) Nullable[T] {
+	return Nullable[T]{val: OfOk(v), set: true}
+}
+
+// NullableOf creates a Nullable of the underlying value of val.
+// Returns a present Nullable if val is ok, a null Nullable if not ok.
+func NullableOf[T any](val Value[T]) Nullable[T] {
+	return Nullable[T]{val: val, set: true}
+}
+
+// IsAbsent returns whether n contains nothing at all.
+func (n Nullable[T]) IsAbsent() bool {
+	return !n.set
+}
+
+// IsNull returns whether n is null.
+func (n Nullable[T]) IsNull() bool {
+	return n.set && !n.val.IsOk()
+}
+
+// IsPresent returns whether n contains a value.
+func (n Nullable[T]) IsPresent() bool {
+	return n.val.IsOk()
+}
+
+// IsZero returns whether n is absent.
+// This enables the use of the omitzero option of encoding/json.
+func (n Nullable[T]) IsZero() bool {
+	return n.IsAbsent()
+}
+
+// Value returns a Value of the underlying value.
+// Returns a not-ok Value if n is absent or null.
+func (n Nullable[T]) Value() Value[T] {
+	return n.val
+}
+
+// Unpack returns a Value of the underlying value and whether n is present or null.
+// This aids in assigning to variables or function arguments.
+func (n Nullable[T]) Unpack() (Value[T], bool) {
+	return n.val, n.set
+}
+
+// Apply returns the result of applying n to val as a patch.
+// Returns val if n is absent, a not-ok Value if n is null,
+// or an ok Value of the underlying value if n is present.
+func (n Nullable[T]) Apply(val Value[T]) Value[T] {
+	if n.IsAbsent() {
+		return val
+	}
+	return n.val
+}
+
+// String returns n formatted as a string.
+func (n Nullable[T]) String() string {
+	switch {
+	case n.IsPresent():
+		return fmt.Sprint(n.val.v)
+	case n.IsNull():
+		return "null"
+	}
+	return "absent"
+}
+
+// MarshalJSON encodes n as JSON.
+// Marshals the underlying value if present, the literal null otherwise.
+//
+// Use the omitzero option to omit an absent field.
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	return n.val.MarshalJSON()
+}
+
+// UnmarshalJSON decodes data into n.
+// n will be null if data is the literal null
+// or present if the underlying value was unmarshaled successfully.
+// A field that is omitted from the JSON remains absent.
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = OfNull[T]()
+		return nil
+	}
+	// unmarshal into temp first in case of error
+	var temp T
+	if err := json.Unmarshal(data, &temp); err != nil {
+		return err
+	}
+	*n = OfPresent(temp)
+	return nil
+}
+
+// MapNullable returns a Nullable of the result of f on the underlying value.
+// Returns n unchanged (as absent or null) if n is not present.
+func MapNullable[T, T2 any](n Nullable[T], f func(T) T2) Nullable[T2] {
+	return Nullable[T2]{val: Map(n.val, f), set: n.set}
+}
+
+// FlatMapNullable returns the result of f on the underlying value.
+// Returns n unchanged (as absent or null) if n is not present.
+func FlatMapNullable[T, T2 any](n Nullable[T], f func(T) Nullable[T2]) Nullable[T2] {
+	if !n.IsPresent() {
+		return Nullable[T2]{set: n.set}
+	}
+	return f(n.val.v)
+}
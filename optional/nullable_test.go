@@ -0,0 +1,252 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"testing"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+// type checks
+var (
+	_ json.Marshaler   = optional.Nullable[int]{}
+	_ json.Unmarshaler = &optional.Nullable[int]{}
+)
+
+// ExampleNullable demonstrates that a Nullable can be used to implement JSON Merge Patch semantics.
+func ExampleNullable() {
+	type User struct {
+		Name  string
+		Email optional.Value[string]
+		Phone optional.Value[string]
+	}
+	type UserPatch struct {
+		Name  optional.Nullable[string] `json:"name"`
+		Email optional.Nullable[string] `json:"email"`
+		Phone optional.Nullable[string] `json:"phone"`
+	}
+
+	user := User{Name: "foo", Email: optional.OfOk("foo@example.com"), Phone: optional.OfOk("555-0100")}
+	var patch UserPatch
+	if err := json.Unmarshal([]byte(`{"email":"bar@example.com","phone":null}`), &patch); err != nil {
+		log.Fatalf("json.Unmarshal() failed: %v", err)
+	}
+	fmt.Println(patch.Name, patch.Email, patch.Phone)
+
+	user.Name = patch.Name.Value().Or(user.Name)
+	user.Email = patch.Email.Apply(user.Email)
+	user.Phone = patch.Phone.Apply(user.Phone)
+	fmt.Println(user.Name, user.Email.OrZero(), user.Phone.IsOk())
+	// Output:
+	// absent bar@example.com null
+	// foo bar@example.com false
+}
+
+func TestNullable_Is(t *testing.T) {
+	tests := []struct {
+		name        string
+		n           optional.Nullable[int]
+		wantAbsent  bool
+		wantNull    bool
+		wantPresent bool
+	}{
+		{
+			name:       "absent",
+			n:          optional.OfAbsent[int](),
+			wantAbsent: true,
+		},
+		{
+			name:       "zero",
+			n:          optional.Nullable[int]{},
+			wantAbsent: true,
+		},
+		{
+			name:     "null",
+			n:        optional.OfNull[int](),
+			wantNull: true,
+		},
+		{
+			name:        "present",
+			n:           optional.OfPresent(0),
+			wantPresent: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.n.IsAbsent(); got != tt.wantAbsent {
+				t.Errorf("IsAbsent() = %v, want %v", got, tt.wantAbsent)
+			}
+			if got := tt.n.IsZero(); got != tt.wantAbsent {
+				t.Errorf("IsZero() = %v, want %v", got, tt.wantAbsent)
+			}
+			if got := tt.n.IsNull(); got != tt.wantNull {
+				t.Errorf("IsNull() = %v, want %v", got, tt.wantNull)
+			}
+			if got := tt.n.IsPresent(); got != tt.wantPresent {
+				t.Errorf("IsPresent() = %v, want %v", got, tt.wantPresent)
+			}
+		})
+	}
+}
+
+func TestNullableOf(t *testing.T) {
+	if got := optional.NullableOf(optional.OfNotOk[string]()); got != optional.OfNull[string]() {
+		t.Errorf("NullableOf() = %v, want %v", got, optional.OfNull[string]())
+	}
+	if got := optional.NullableOf(optional.OfOk("foo")); got != optional.OfPresent("foo") {
+		t.Errorf("NullableOf() = %v, want %v", got, optional.OfPresent("foo"))
+	}
+}
+
+func TestNullable_Value(t *testing.T) {
+	if got := optional.OfAbsent[string]().Value(); got != optional.OfNotOk[string]() {
+		t.Errorf("Value() = %v, want %v", got, optional.OfNotOk[string]())
+	}
+	if got := optional.OfNull[string]().Value(); got != optional.OfNotOk[string]() {
+		t.Errorf("Value() = %v, want %v", got, optional.OfNotOk[string]())
+	}
+	if got := optional.OfPresent("foo").Value(); got != optional.OfOk("foo") {
+		t.Errorf("Value() = %v, want %v", got, optional.OfOk("foo"))
+	}
+}
+
+func TestNullable_Unpack(t *testing.T) {
+	if val, ok := optional.OfAbsent[string]().Unpack(); val.IsOk() || ok {
+		t.Errorf("Unpack() = %v %v, want %v %v", val, ok, optional.OfNotOk[string](), false)
+	}
+	if val, ok := optional.OfNull[string]().Unpack(); val.IsOk() || !ok {
+		t.Errorf("Unpack() = %v %v, want %v %v", val, ok, optional.OfNotOk[string](), true)
+	}
+	if val, ok := optional.OfPresent("foo").Unpack(); val != optional.OfOk("foo") || !ok {
+		t.Errorf("Unpack() = %v %v, want %v %v", val, ok, optional.OfOk("foo"), true)
+	}
+}
+
+func TestNullable_Apply(t *testing.T) {
+	val := optional.OfOk(1)
+	if got := optional.OfAbsent[int]().Apply(val); got != val {
+		t.Errorf("Apply() = %v, want %v", got, val)
+	}
+	if got := optional.OfNull[int]().Apply(val); got != optional.OfNotOk[int]() {
+		t.Errorf("Apply() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+	if got := optional.OfPresent(2).Apply(val); got != optional.OfOk(2) {
+		t.Errorf("Apply() = %v, want %v", got, optional.OfOk(2))
+	}
+}
+
+func TestNullable_MarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		n    optional.Nullable[string]
+		want string
+	}{
+		{
+			name: "absent",
+			n:    optional.OfAbsent[string](),
+			want: `null`,
+		},
+		{
+			name: "null",
+			n:    optional.OfNull[string](),
+			want: `null`,
+		},
+		{
+			name: "present",
+			n:    optional.OfPresent("foo"),
+			want: `"foo"`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got, err := tt.n.MarshalJSON(); string(got) != tt.want || err != nil {
+				t.Errorf("MarshalJSON() = %s %v, want %s %v", got, err, tt.want, nil)
+			}
+		})
+	}
+}
+
+func TestNullable_UnmarshalJSON(t *testing.T) {
+	type obj struct {
+		Val optional.Nullable[int] `json:"val"`
+	}
+	tests := []struct {
+		name    string
+		data    string
+		want    optional.Nullable[int]
+		wantErr bool
+	}{
+		{
+			name: "absent",
+			data: `{}`,
+			want: optional.OfAbsent[int](),
+		},
+		{
+			name: "null",
+			data: `{"val":null}`,
+			want: optional.OfNull[int](),
+		},
+		{
+			name: "present",
+			data: `{"val":0}`,
+			want: optional.OfPresent(0),
+		},
+		{
+			name:    "invalid",
+			data:    `{"val":"foo"}`,
+			want:    optional.OfAbsent[int](),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got obj
+			if err := json.Unmarshal([]byte(tt.data), &got); (err != nil) != tt.wantErr {
+				t.Errorf("json.Unmarshal() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got.Val != tt.want {
+				t.Errorf("val after json.Unmarshal() = %v, want %v", got.Val, tt.want)
+			}
+		})
+	}
+}
+
+func TestMapNullable(t *testing.T) {
+	if got := optional.MapNullable(optional.OfAbsent[int](), strconv.Itoa); got != optional.OfAbsent[string]() {
+		t.Errorf("MapNullable() = %v, want %v", got, optional.OfAbsent[string]())
+	}
+	if got := optional.MapNullable(optional.OfNull[int](), strconv.Itoa); got != optional.OfNull[string]() {
+		t.Errorf("MapNullable() = %v, want %v", got, optional.OfNull[string]())
+	}
+	if got := optional.MapNullable(optional.OfPresent(1), strconv.Itoa); got != optional.OfPresent("1") {
+		t.Errorf("MapNullable() = %v, want %v", got, optional.OfPresent("1"))
+	}
+}
+
+func TestFlatMapNullable(t *testing.T) {
+	nullIfNegative := func(i int) optional.Nullable[string] {
+		if i < 0 {
+			return optional.OfNull[string]()
+		}
+		return optional.OfPresent(strconv.Itoa(i))
+	}
+	if got := optional.FlatMapNullable(optional.OfAbsent[int](), nullIfNegative); got != optional.OfAbsent[string]() {
+		t.Errorf("FlatMapNullable() = %v, want %v", got, optional.OfAbsent[string]())
+	}
+	if got := optional.FlatMapNullable(optional.OfNull[int](), nullIfNegative); got != optional.OfNull[string]() {
+		t.Errorf("FlatMapNullable() = %v, want %v", got, optional.OfNull[string]())
+	}
+	if got := optional.FlatMapNullable(optional.OfPresent(-1), nullIfNegative); got != optional.OfNull[string]() {
+		t.Errorf("FlatMapNullable() = %v, want %v", got, optional.OfNull[string]())
+	}
+	if got := optional.FlatMapNullable(optional.OfPresent(1), nullIfNegative); got != optional.OfPresent("1") {
+		t.Errorf("FlatMapNullable() = %v, want %v", got, optional.OfPresent("1"))
+	}
+}
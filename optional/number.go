@@ -0,0 +1,78 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+// Number is a constraint that permits any integer or floating-point type.
+type Number interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
+		~float32 | ~float64
+}
+
+// Sum returns a Value of the sum of the underlying values of vals that are ok.
+// Returns a not-ok Value if none of vals are ok, like SQL SUM.
+func Sum[N Number](vals []Value[N]) Value[N] {
+	return reduce(vals, func(sum, v N) N { return sum + v })
+}
+
+// Min returns a Value of the smallest underlying value of vals that are ok.
+// Returns a not-ok Value if none of vals are ok, like SQL MIN.
+func Min[N Number](vals []Value[N]) Value[N] {
+	return reduce(vals, func(m, v N) N { return min(m, v) })
+}
+
+// Max returns a Value of the largest underlying value of vals that are ok.
+// Returns a not-ok Value if none of vals are ok, like SQL MAX.
+func Max[N Number](vals []Value[N]) Value[N] {
+	return reduce(vals, func(m, v N) N { return max(m, v) })
+}
+
+// Average returns a Value of the mean of the underlying values of vals that are ok.
+// Returns a not-ok Value if none of vals are ok, like SQL AVG.
+func Average[N Number](vals []Value[N]) Value[float64] {
+	var sum float64
+	var n int
+	for _, val := range vals {
+		if val.IsOk() {
+			sum += float64(val.v)
+			n++
+		}
+	}
+	return Of(sum/float64(n), n > 0)
+}
+
+// Add returns a Value of the sum of the underlying values of val and val2.
+// Returns a not-ok Value if either val or val2 is not ok, like SQL NULL arithmetic.
+func Add[N Number](val, val2 Value[N]) Value[N] {
+	return ZipWith(val, val2, func(v, v2 N) N { return v + v2 })
+}
+
+// Sub returns a Value of the difference of the underlying values of val and val2.
+// Returns a not-ok Value if either val or val2 is not ok, like SQL NULL arithmetic.
+func Sub[N Number](val, val2 Value[N]) Value[N] {
+	return ZipWith(val, val2, func(v, v2 N) N { return v - v2 })
+}
+
+// Mul returns a Value of the product of the underlying values of val and val2.
+// Returns a not-ok Value if either val or val2 is not ok, like SQL NULL arithmetic.
+func Mul[N Number](val, val2 Value[N]) Value[N] {
+	return ZipWith(val, val2, func(v, v2 N) N { return v * v2 })
+}
+
+// reduce combines the underlying values of vals that are ok with f.
+// Returns a not-ok Value if none of vals are ok.
+func reduce[T any](vals []Value[T], f func(T, T) T) Value[T] {
+	var acc Value[T]
+	for _, val := range vals {
+		switch {
+		case !val.IsOk():
+		case !acc.IsOk():
+			acc = val
+		default:
+			acc.v = f(acc.v, val.v)
+		}
+	}
+	return acc
+}
@@ -0,0 +1,130 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+func ExampleSum() {
+	vals := []optional.Value[int]{optional.OfOk(1), optional.OfNotOk[int](), optional.OfOk(2)}
+	fmt.Println(optional.Sum(vals).MustOk(), optional.Average(vals).MustOk())
+	fmt.Println(optional.Sum([]optional.Value[int]{optional.OfNotOk[int]()}).IsOk())
+	// Output:
+	// 3 1.5
+	// false
+}
+
+func TestAggregates(t *testing.T) {
+	tests := []struct {
+		name        string
+		vals        []optional.Value[int]
+		wantSum     optional.Value[int]
+		wantMin     optional.Value[int]
+		wantMax     optional.Value[int]
+		wantAverage optional.Value[float64]
+	}{
+		{
+			name:        "empty",
+			vals:        nil,
+			wantSum:     optional.OfNotOk[int](),
+			wantMin:     optional.OfNotOk[int](),
+			wantMax:     optional.OfNotOk[int](),
+			wantAverage: optional.OfNotOk[float64](),
+		},
+		{
+			name:        "not ok",
+			vals:        []optional.Value[int]{optional.OfNotOk[int](), optional.OfNotOk[int]()},
+			wantSum:     optional.OfNotOk[int](),
+			wantMin:     optional.OfNotOk[int](),
+			wantMax:     optional.OfNotOk[int](),
+			wantAverage: optional.OfNotOk[float64](),
+		},
+		{
+			name:        "single",
+			vals:        []optional.Value[int]{optional.OfNotOk[int](), optional.OfOk(0)},
+			wantSum:     optional.OfOk(0),
+			wantMin:     optional.OfOk(0),
+			wantMax:     optional.OfOk(0),
+			wantAverage: optional.OfOk(0.0),
+		},
+		{
+			name:        "mixed",
+			vals:        []optional.Value[int]{optional.OfOk(3), optional.OfNotOk[int](), optional.OfOk(-1), optional.OfOk(4)},
+			wantSum:     optional.OfOk(6),
+			wantMin:     optional.OfOk(-1),
+			wantMax:     optional.OfOk(4),
+			wantAverage: optional.OfOk(2.0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := optional.Sum(tt.vals); got != tt.wantSum {
+				t.Errorf("Sum() = %v, want %v", got, tt.wantSum)
+			}
+			if got := optional.Min(tt.vals); got != tt.wantMin {
+				t.Errorf("Min() = %v, want %v", got, tt.wantMin)
+			}
+			if got := optional.Max(tt.vals); got != tt.wantMax {
+				t.Errorf("Max() = %v, want %v", got, tt.wantMax)
+			}
+			if got := optional.Average(tt.vals); got != tt.wantAverage {
+				t.Errorf("Average() = %v, want %v", got, tt.wantAverage)
+			}
+		})
+	}
+}
+
+func TestArithmetic(t *testing.T) {
+	tests := []struct {
+		name    string
+		val     optional.Value[float64]
+		val2    optional.Value[float64]
+		wantAdd optional.Value[float64]
+		wantSub optional.Value[float64]
+		wantMul optional.Value[float64]
+	}{
+		{
+			name:    "not ok",
+			val:     optional.OfNotOk[float64](),
+			val2:    optional.OfOk(2.0),
+			wantAdd: optional.OfNotOk[float64](),
+			wantSub: optional.OfNotOk[float64](),
+			wantMul: optional.OfNotOk[float64](),
+		},
+		{
+			name:    "not ok 2",
+			val:     optional.OfOk(2.0),
+			val2:    optional.OfNotOk[float64](),
+			wantAdd: optional.OfNotOk[float64](),
+			wantSub: optional.OfNotOk[float64](),
+			wantMul: optional.OfNotOk[float64](),
+		},
+		{
+			name:    "ok",
+			val:     optional.OfOk(1.5),
+			val2:    optional.OfOk(2.0),
+			wantAdd: optional.OfOk(3.5),
+			wantSub: optional.OfOk(-0.5),
+			wantMul: optional.OfOk(3.0),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := optional.Add(tt.val, tt.val2); got != tt.wantAdd {
+				t.Errorf("Add() = %v, want %v", got, tt.wantAdd)
+			}
+			if got := optional.Sub(tt.val, tt.val2); got != tt.wantSub {
+				t.Errorf("Sub() = %v, want %v", got, tt.wantSub)
+			}
+			if got := optional.Mul(tt.val, tt.val2); got != tt.wantMul {
+				t.Errorf("Mul() = %v, want %v", got, tt.wantMul)
+			}
+		})
+	}
+}
@@ -0,0 +1,151 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/json"
+	"reflect"
+	"strings"
+)
+
+// omitter is implemented by Value and Nullable (and types that embed them)
+// to report whether MarshalJSONOmitNotOk should omit them.
+type omitter interface {
+	omit() bool
+}
+
+var (
+	marshalerType     = reflect.TypeOf((*json.Marshaler)(nil)).Elem()
+	textMarshalerType = reflect.TypeOf((*encoding.TextMarshaler)(nil)).Elem()
+)
+
+// MarshalJSONOmitNotOk encodes v as JSON like json.Marshal but omits struct fields that are not ok.
+// More precisely, it omits fields that are a not-ok Value or an absent Nullable.
+// Other fields are kept even if they're zero, e.g. a zero time.Time.
+// This applies to the fields of v and of any nested structs that are not marshaled by their own methods.
+//
+// It's equivalent to specifying the omitzero option for every Value and Nullable field.
+func MarshalJSONOmitNotOk(v any) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	return omitNotOk(reflect.ValueOf(v), data)
+}
+
+// omitNotOk removes the members of the JSON object data
+// that correspond to not-ok fields of the struct rv.
+// Returns data unchanged if rv is not a struct.
+func omitNotOk(rv reflect.Value, data []byte) ([]byte, error) {
+	for rv.Kind() == reflect.Pointer || rv.Kind() == reflect.Interface {
+		if rv.IsNil() {
+			return data, nil
+		}
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct || isMarshaler(rv.Type()) {
+		return data, nil
+	}
+	fields := make(map[string]reflect.Value)
+	jsonFields(rv, fields)
+
+	dec := json.NewDecoder(bytes.NewReader(data))
+	if tok, err := dec.Token(); err != nil || tok != json.Delim('{') {
+		// not an object
+		return data, err
+	}
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for dec.More() {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, err
+		}
+		key, _ := tok.(string)
+		var raw json.RawMessage
+		if err = dec.Decode(&raw); err != nil {
+			return nil, err
+		}
+		if fv, ok := fields[key]; ok && fv.CanInterface() {
+			if o, ok := fv.Interface().(omitter); ok && o.omit() {
+				continue
+			}
+			if raw, err = omitNotOk(fv, raw); err != nil {
+				return nil, err
+			}
+		}
+		if buf.Len() > 1 {
+			buf.WriteByte(',')
+		}
+		keyData, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(keyData)
+		buf.WriteByte(':')
+		buf.Write(raw)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// jsonFields adds the fields of the struct rv to fields keyed by JSON name.
+// Fields of embedded structs are added after the fields of rv so that the latter take precedence.
+func jsonFields(rv reflect.Value, fields map[string]reflect.Value) {
+	t := rv.Type()
+	var embedded []reflect.Value
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		tag := sf.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if sf.Anonymous && name == "" {
+			fv := rv.Field(i)
+			if fv.Kind() == reflect.Pointer {
+				if fv.IsNil() {
+					continue
+				}
+				fv = fv.Elem()
+			}
+			if fv.Kind() == reflect.Struct {
+				embedded = append(embedded, fv)
+				continue
+			}
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		if _, ok := fields[name]; !ok {
+			fields[name] = rv.Field(i)
+		}
+	}
+	for _, fv := range embedded {
+		jsonFields(fv, fields)
+	}
+}
+
+// omit reports whether val is not ok.
+func (val Value[T]) omit() bool {
+	return !val.IsOk()
+}
+
+// omit reports whether n is absent.
+func (n Nullable[T]) omit() bool {
+	return n.IsAbsent()
+}
+
+// isMarshaler returns whether t or a pointer to t implements json.Marshaler or encoding.TextMarshaler.
+func isMarshaler(t reflect.Type) bool {
+	pt := reflect.PointerTo(t)
+	return t.Implements(marshalerType) || pt.Implements(marshalerType) ||
+		t.Implements(textMarshalerType) || pt.Implements(textMarshalerType)
+}
@@ -0,0 +1,125 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"testing"
+	"time"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+// Example_omitzero demonstrates that the omitzero option omits a not-ok Value.
+func Example_omitzero() {
+	type Obj struct {
+		Name string              `json:"name"`
+		Val  optional.Value[int] `json:"val,omitzero"`
+	}
+	b, err := json.Marshal(Obj{Name: "foo", Val: optional.OfNotOk[int]()})
+	if err != nil {
+		log.Fatalf("json.Marshal() failed: %v", err)
+	}
+	fmt.Println(string(b))
+	// Output: {"name":"foo"}
+}
+
+func ExampleMarshalJSONOmitNotOk() {
+	type Obj struct {
+		Name string              `json:"name"`
+		Val  optional.Value[int] `json:"val"`
+	}
+	b, err := optional.MarshalJSONOmitNotOk(Obj{Name: "foo", Val: optional.OfNotOk[int]()})
+	if err != nil {
+		log.Fatalf("MarshalJSONOmitNotOk() failed: %v", err)
+	}
+	fmt.Println(string(b))
+	// Output: {"name":"foo"}
+}
+
+func TestValue_IsZero(t *testing.T) {
+	if got := optional.OfNotOk[int]().IsZero(); !got {
+		t.Errorf("IsZero() = %v, want %v", got, true)
+	}
+	if got := optional.OfOk(0).IsZero(); got {
+		t.Errorf("IsZero() = %v, want %v", got, false)
+	}
+}
+
+type Inner struct {
+	A optional.Value[int] `json:"a"`
+	B optional.Value[int]
+}
+
+type marshaler struct {
+	Val optional.Value[int]
+}
+
+func (marshaler) MarshalJSON() ([]byte, error) {
+	return []byte(`{"Val":null}`), nil
+}
+
+func TestMarshalJSONOmitNotOk(t *testing.T) {
+	type outer struct {
+		Inner
+		Name    string                 `json:"name"`
+		Ignored optional.Value[int]    `json:"-"`
+		Str     optional.Value[string] `json:"str,omitempty"`
+		Nested  Inner                  `json:"nested"`
+		Ptr     *Inner                 `json:"ptr"`
+		Any     any                    `json:"any"`
+		Custom  marshaler              `json:"custom"`
+		Time    time.Time              `json:"time"`
+		Slice   []optional.Value[int]  `json:"slice"`
+		Patch   optional.Nullable[int] `json:"patch"`
+	}
+	tests := []struct {
+		name    string
+		v       any
+		want    string
+		wantErr bool
+	}{
+		{
+			name: "not ok",
+			v:    outer{Name: "foo", Slice: []optional.Value[int]{optional.OfNotOk[int]()}},
+			want: `{"name":"foo","nested":{},"ptr":null,"any":null,"custom":{"Val":null},"time":"0001-01-01T00:00:00Z","slice":[null]}`,
+		},
+		{
+			name: "ok",
+			v: &outer{
+				Inner:  Inner{A: optional.OfOk(1), B: optional.OfOk(2)},
+				Str:    optional.OfOk(""),
+				Nested: Inner{B: optional.OfOk(3)},
+				Ptr:    &Inner{A: optional.OfOk(4)},
+				Any:    Inner{B: optional.OfOk(5)},
+				Patch:  optional.OfNull[int](),
+			},
+			want: `{"a":1,"B":2,"name":"","str":"","nested":{"B":3},"ptr":{"a":4},"any":{"B":5},"custom":{"Val":null},"time":"0001-01-01T00:00:00Z","slice":null,"patch":null}`,
+		},
+		{
+			name: "not struct",
+			v:    []optional.Value[int]{optional.OfNotOk[int]()},
+			want: `[null]`,
+		},
+		{
+			name:    "invalid",
+			v:       make(chan int),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := optional.MarshalJSONOmitNotOk(tt.v)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("MarshalJSONOmitNotOk() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if string(got) != tt.want {
+				t.Errorf("MarshalJSONOmitNotOk() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional
+
+import "iter"
+
+// Seq returns a sequence that yields the underlying value if ok.
+// The sequence is empty if not ok.
+func (val Value[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if val.IsOk() {
+			yield(val.v)
+		}
+	}
+}
+
+// Values returns a sequence of the underlying values of the Values in seq that are ok.
+func Values[T any](seq iter.Seq[Value[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for val := range seq {
+			if val.IsOk() && !yield(val.v) {
+				return
+			}
+		}
+	}
+}
+
+// FilterMap returns a sequence of the underlying values of the results of f
+// on the elements of seq that are ok.
+func FilterMap[T, T2 any](seq iter.Seq[T], f func(T) Value[T2]) iter.Seq[T2] {
+	return func(yield func(T2) bool) {
+		for v := range seq {
+			if val := f(v); val.IsOk() && !yield(val.v) {
+				return
+			}
+		}
+	}
+}
+
+// FirstOk returns the first Value in seq that is ok.
+// Returns a not-ok Value if none of them are ok.
+func FirstOk[T any](seq iter.Seq[Value[T]]) Value[T] {
+	for val := range seq {
+		if val.IsOk() {
+			return val
+		}
+	}
+	return OfNotOk[T]()
+}
+
+// TakeWhileOk returns a sequence of the underlying values of the Values in seq
+// up to, but not including, the first Value that is not ok.
+func TakeWhileOk[T any](seq iter.Seq[Value[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for val := range seq {
+			if !val.IsOk() || !yield(val.v) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package optional_test
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/phelmkamp/valor/optional"
+)
+
+func ExampleValue_Seq() {
+	m := map[string]int{"foo": 42}
+	for v := range optional.OfIndex(m, "foo").Seq() {
+		fmt.Println(v)
+	}
+	for v := range optional.OfIndex(m, "bar").Seq() {
+		fmt.Println(v)
+	}
+	// Output: 42
+}
+
+func ExampleFilterMap() {
+	atoi := func(s string) optional.Value[int] {
+		i, err := strconv.Atoi(s)
+		return optional.Of(i, err == nil)
+	}
+	seq := optional.FilterMap(slices.Values([]string{"1", "foo", "3"}), atoi)
+	fmt.Println(slices.Collect(seq))
+	// Output: [1 3]
+}
+
+var seqVals = []optional.Value[int]{
+	optional.OfOk(1),
+	optional.OfOk(2),
+	optional.OfNotOk[int](),
+	optional.OfOk(4),
+}
+
+func TestValue_Seq(t *testing.T) {
+	if got := slices.Collect(optional.OfNotOk[int]().Seq()); got != nil {
+		t.Errorf("Seq() = %v, want %v", got, nil)
+	}
+	if got, want := slices.Collect(optional.OfOk(1).Seq()), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Seq() = %v, want %v", got, want)
+	}
+}
+
+func TestValues(t *testing.T) {
+	if got, want := slices.Collect(optional.Values(slices.Values(seqVals))), []int{1, 2, 4}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+	// stop early
+	for v := range optional.Values(slices.Values(seqVals)) {
+		if v != 1 {
+			t.Errorf("Values() yielded %v after break", v)
+		}
+		break
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	isEven := func(i int) optional.Value[string] {
+		return optional.Of(strconv.Itoa(i), i%2 == 0)
+	}
+	if got, want := slices.Collect(optional.FilterMap(slices.Values([]int{1, 2, 3, 4}), isEven)), []string{"2", "4"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterMap() = %v, want %v", got, want)
+	}
+}
+
+func TestFirstOk(t *testing.T) {
+	if got := optional.FirstOk(slices.Values(seqVals[2:])); got != optional.OfOk(4) {
+		t.Errorf("FirstOk() = %v, want %v", got, optional.OfOk(4))
+	}
+	if got := optional.FirstOk(slices.Values(seqVals[2:3])); got != optional.OfNotOk[int]() {
+		t.Errorf("FirstOk() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+}
+
+func TestTakeWhileOk(t *testing.T) {
+	if got, want := slices.Collect(optional.TakeWhileOk(slices.Values(seqVals))), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeWhileOk() = %v, want %v", got, want)
+	}
+}
@@ -5,7 +5,11 @@
 package optional
 
 import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding/json"
+	"time"
 )
 
 // Value either contains a value (ok) or nothing (not ok).
@@ -62,6 +66,47 @@ func OfReceive[T any](ch <-chan T) Value[T] {
 	return Of(v, ok)
 }
 
+// OfReceiveContext performs a blocking receive on ch and creates a Value of the result.
+// Returns a not-ok Value if ch is nil, closed, or ctx is done before a value is received.
+func OfReceiveContext[T any](ctx context.Context, ch <-chan T) Value[T] {
+	if ch == nil {
+		return OfNotOk[T]()
+	}
+	select {
+	case v, ok := <-ch:
+		return Of(v, ok)
+	case <-ctx.Done():
+		return OfNotOk[T]()
+	}
+}
+
+// OfReceiveTimeout performs a blocking receive on ch and creates a Value of the result.
+// Returns a not-ok Value if ch is nil, closed, or d elapses before a value is received.
+func OfReceiveTimeout[T any](ch <-chan T, d time.Duration) Value[T] {
+	if ch == nil {
+		return OfNotOk[T]()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case v, ok := <-ch:
+		return Of(v, ok)
+	case <-t.C:
+		return OfNotOk[T]()
+	}
+}
+
+// OfTryReceive performs a non-blocking receive on ch and creates a Value of the result.
+// Returns a not-ok Value if ch is nil, closed, or has no value ready.
+func OfTryReceive[T any](ch <-chan T) Value[T] {
+	select {
+	case v, ok := <-ch:
+		return Of(v, ok)
+	default:
+		return OfNotOk[T]()
+	}
+}
+
 // OfNotOk creates a Value that is not ok.
 // This aids in comparisons, enabling the use of Value in switch statements.
 func OfNotOk[T any]() Value[T] {
@@ -73,6 +118,12 @@ func (val Value[T]) IsOk() bool {
 	return val.ok
 }
 
+// IsZero returns whether val is not ok.
+// This enables the use of the omitzero option of encoding/json.
+func (val Value[T]) IsZero() bool {
+	return !val.IsOk()
+}
+
 // Ok sets dst to the underlying value if ok.
 // Returns true if ok, false if not ok.
 func (val Value[T]) Ok(dst *T) bool {
@@ -164,6 +215,34 @@ func (val *Value[T]) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+// Scan implements the sql.Scanner interface.
+// val will be ok if src was converted to the underlying type successfully.
+// Sets val to not-ok if src is nil (i.e. NULL).
+//
+// Conversion follows the rules of sql.Rows.Scan,
+// including the use of the underlying type's Scan method if present.
+func (val *Value[T]) Scan(src any) error {
+	// scan into temp first in case of error
+	var temp sql.Null[T]
+	if err := temp.Scan(src); err != nil {
+		return err
+	}
+	val.v, val.ok = temp.V, temp.Valid
+	return nil
+}
+
+// Value implements the driver.Valuer interface.
+// Converts the underlying value to a driver.Value if ok, returns nil (i.e. NULL) if not ok.
+//
+// Conversion follows the rules of driver.DefaultParameterConverter,
+// including the use of the underlying type's Value method if present.
+func (val Value[T]) Value() (driver.Value, error) {
+	if !val.IsOk() {
+		return nil, nil
+	}
+	return driver.DefaultParameterConverter.ConvertValue(val.v)
+}
+
 // Unpack returns the underlying value and whether it is ok.
 // This aids in assigning to variables or function arguments.
 func (val Value[T]) Unpack() (T, bool) {
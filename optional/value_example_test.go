@@ -23,8 +23,8 @@ func Example_map() {
 	fmt.Println(m.Index("foo"))
 	fmt.Println(m.Index("bar"))
 	// Output:
-	// {0 true}
-	// {0 false}
+	// Some(0)
+	// None
 }
 
 type Chan[T any] struct {
@@ -48,8 +48,8 @@ func Example_channel() {
 	ch.Close()
 	fmt.Println(ch.Receive())
 	// Output:
-	// {0 true}
-	// {0 false}
+	// Some(0)
+	// None
 }
 
 // Example_json demonstrates that a Value can be marshaled to and unmarshaled from JSON.
@@ -84,8 +84,8 @@ func Example_json() {
 	fmt.Println(obj)
 	// Output:
 	// {"name":"foo","val":0}
-	// {foo {0 true}}
+	// {foo Some(0)}
 	//
 	// {"name":"foo","val":null}
-	// {foo {0 false}}
+	// {foo None}
 }
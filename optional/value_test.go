@@ -6,6 +6,9 @@ package optional_test
 
 import (
 	"bytes"
+	"context"
+	"database/sql"
+	"database/sql/driver"
 	"encoding"
 	"encoding/json"
 	"fmt"
@@ -32,6 +35,8 @@ var (
 
 	_ json.Marshaler   = optional.Value[int]{}
 	_ json.Unmarshaler = &optional.Value[int]{}
+	_ sql.Scanner      = &optional.Value[int]{}
+	_ driver.Valuer    = optional.Value[int]{}
 )
 
 func Example() {
@@ -43,7 +48,7 @@ func Example() {
 	fmt.Println(val.Ok(&foo), foo) // true 42
 
 	valStr := optional.Map(val, strconv.Itoa)
-	fmt.Println(valStr) // {42 true}
+	fmt.Println(valStr) // Some(42)
 
 	val = optional.OfIndex(m, "bar")
 	fmt.Println(val.Or(-1))                          // -1
@@ -61,7 +66,7 @@ func Example() {
 	}
 	// Output: true
 	// true 42
-	// {42 true}
+	// Some(42)
 	// -1
 	// 0
 	// 1
@@ -185,7 +190,7 @@ func ExampleUnzipWith_tuple() {
 	v2, v4 := optional.UnzipWith(v24, singleton.SetUnzip[string, []int])
 	fmt.Println(v1.MustOk(), v3.MustOk(), v2.MustOk(), v4.MustOk())
 	// Output:
-	// {1 3} {two [4]}
+	// (1, 3) (two, [4])
 	// {1} {3} {two} {[4]}
 }
 
@@ -326,8 +331,8 @@ func ExampleZipWith_tuple() {
 	v1324 := optional.ZipWith(v12, v34, two.TupleZip[int, float64, string, []int])
 	fmt.Println(v1324.MustOk())
 	// Output:
-	// {1 two} {3 [4]}
-	// {1 3 two [4]}
+	// (1, two) (3, [4])
+	// (1, 3, two, [4])
 }
 
 func TestZipWith(t *testing.T) {
@@ -564,3 +569,180 @@ func TestValue_Unpack(t *testing.T) {
 		t.Errorf("Unpack() = %v %v, want %v %v", v, ok, "foo", true)
 	}
 }
+
+// upper is a string that is stored in upper case.
+type upper string
+
+func (u *upper) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("cannot scan %T into upper", src)
+	}
+	*u = upper(strings.ToUpper(s))
+	return nil
+}
+
+func (u upper) Value() (driver.Value, error) {
+	return strings.ToUpper(string(u)), nil
+}
+
+func TestValue_Scan(t *testing.T) {
+	tests := []struct {
+		name    string
+		src     any
+		want    optional.Value[int64]
+		wantErr bool
+	}{
+		{
+			name: "null",
+			src:  nil,
+			want: optional.OfNotOk[int64](),
+		},
+		{
+			name: "int64",
+			src:  int64(42),
+			want: optional.OfOk(int64(42)),
+		},
+		{
+			name: "bytes",
+			src:  []byte("42"),
+			want: optional.OfOk(int64(42)),
+		},
+		{
+			name:    "invalid",
+			src:     "foo",
+			want:    optional.OfOk(int64(-1)),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			val := optional.OfOk(int64(-1))
+			if err := val.Scan(tt.src); (err != nil) != tt.wantErr {
+				t.Errorf("Scan() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if val != tt.want {
+				t.Errorf("val after Scan() = %v, want %v", val, tt.want)
+			}
+		})
+	}
+
+	// Scanner
+	var val optional.Value[upper]
+	if err := val.Scan("foo"); err != nil {
+		t.Errorf("Scan() error = %v, want %v", err, nil)
+	}
+	if val != optional.OfOk(upper("FOO")) {
+		t.Errorf("val after Scan() = %v, want %v", val, optional.OfOk(upper("FOO")))
+	}
+}
+
+func TestValue_Value(t *testing.T) {
+	type myInt int
+	tests := []struct {
+		name    string
+		val     driver.Valuer
+		want    driver.Value
+		wantErr bool
+	}{
+		{
+			name: "not ok",
+			val:  optional.OfNotOk[string](),
+			want: nil,
+		},
+		{
+			name: "string",
+			val:  optional.OfOk("foo"),
+			want: "foo",
+		},
+		{
+			name: "kind",
+			val:  optional.OfOk(myInt(42)),
+			want: int64(42),
+		},
+		{
+			name: "Valuer",
+			val:  optional.OfOk(upper("foo")),
+			want: "FOO",
+		},
+		{
+			name:    "unsupported",
+			val:     optional.OfOk(struct{}{}),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := tt.val.Value()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Value() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("Value() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestOfReceiveContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	// nil
+	if got := optional.OfReceiveContext[int](ctx, nil); got != optional.OfNotOk[int]() {
+		t.Errorf("OfReceiveContext() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+	// ok
+	ch := make(chan int, 1)
+	ch <- 42
+	if got := optional.OfReceiveContext(ctx, ch); got != optional.OfOk(42) {
+		t.Errorf("OfReceiveContext() = %v, want %v", got, optional.OfOk(42))
+	}
+	// canceled
+	cancel()
+	if got := optional.OfReceiveContext(ctx, ch); got != optional.OfNotOk[int]() {
+		t.Errorf("OfReceiveContext() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+	// closed
+	close(ch)
+	if got := optional.OfReceiveContext(context.Background(), ch); got != optional.OfNotOk[int]() {
+		t.Errorf("OfReceiveContext() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+}
+
+func TestOfReceiveTimeout(t *testing.T) {
+	// nil
+	if got := optional.OfReceiveTimeout[int](nil, time.Hour); got != optional.OfNotOk[int]() {
+		t.Errorf("OfReceiveTimeout() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+	// ok
+	ch := make(chan int, 1)
+	ch <- 42
+	if got := optional.OfReceiveTimeout(ch, time.Hour); got != optional.OfOk(42) {
+		t.Errorf("OfReceiveTimeout() = %v, want %v", got, optional.OfOk(42))
+	}
+	// timed out
+	if got := optional.OfReceiveTimeout(ch, time.Millisecond); got != optional.OfNotOk[int]() {
+		t.Errorf("OfReceiveTimeout() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+}
+
+func TestOfTryReceive(t *testing.T) {
+	// nil
+	if got := optional.OfTryReceive[int](nil); got != optional.OfNotOk[int]() {
+		t.Errorf("OfTryReceive() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+	// not ready
+	ch := make(chan int, 1)
+	if got := optional.OfTryReceive(ch); got != optional.OfNotOk[int]() {
+		t.Errorf("OfTryReceive() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+	// ok
+	ch <- 42
+	if got := optional.OfTryReceive(ch); got != optional.OfOk(42) {
+		t.Errorf("OfTryReceive() = %v, want %v", got, optional.OfOk(42))
+	}
+	// closed
+	close(ch)
+	if got := optional.OfTryReceive(ch); got != optional.OfNotOk[int]() {
+		t.Errorf("OfTryReceive() = %v, want %v", got, optional.OfNotOk[int]())
+	}
+}
@@ -0,0 +1,46 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+// Collect returns a Result of the underlying values of results.
+// Returns a Result of the first error if any of results is not ok.
+func Collect[T any](results []Result[T]) Result[[]T] {
+	s := make([]T, 0, len(results))
+	for _, res := range results {
+		if res.err != nil {
+			return Result[[]T]{err: res.err}
+		}
+		s = append(s, res.v)
+	}
+	return OfOk(s)
+}
+
+// CollectAll returns a Result of the underlying values of results.
+// Returns a Result of every error joined as a *MultiError if any of results contains an error.
+// Returns a Result that contains neither a value nor an error if any of results was created by OfError(nil).
+func CollectAll[T any](results []Result[T]) Result[[]T] {
+	vals, errs := Partition(results)
+	if len(errs) > 0 {
+		return OfError[[]T](joinErrors(errs...))
+	}
+	if len(vals) < len(results) {
+		return OfError[[]T](nil)
+	}
+	return OfOk(vals)
+}
+
+// Partition separates the underlying values and errors of results.
+// Results created by OfError(nil) are omitted from both.
+func Partition[T any](results []Result[T]) (vals []T, errs []error) {
+	for _, res := range results {
+		switch {
+		case res.err == nil:
+			vals = append(vals, res.v)
+		case res.IsError():
+			errs = append(errs, res.err)
+		}
+	}
+	return
+}
@@ -0,0 +1,120 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+var errOther = errors.New("other")
+
+func ExampleCollectAll() {
+	var results []result.Result[int]
+	for _, s := range []string{"1", "foo", "3", "bar"} {
+		results = append(results, result.Of(strconv.Atoi(s)))
+	}
+	fmt.Println(result.CollectAll(results).Error())
+	// Output:
+	// strconv.Atoi: parsing "foo": invalid syntax
+	// strconv.Atoi: parsing "bar": invalid syntax
+}
+
+func TestCollect(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []result.Result[int]
+		want    result.Result[[]int]
+	}{
+		{
+			name:    "empty",
+			results: nil,
+			want:    result.OfOk([]int{}),
+		},
+		{
+			name:    "ok",
+			results: []result.Result[int]{result.OfOk(1), result.OfOk(2)},
+			want:    result.OfOk([]int{1, 2}),
+		},
+		{
+			name:    "error",
+			results: []result.Result[int]{result.OfOk(1), result.OfError[int](errFail), result.OfError[int](errOther)},
+			want:    result.OfError[[]int](errFail),
+		},
+		{
+			name:    "nil error",
+			results: []result.Result[int]{result.OfOk(1), result.OfError[int](nil), result.OfError[int](errOther)},
+			want:    result.OfError[[]int](nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.Collect(tt.results); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Collect() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCollectAll(t *testing.T) {
+	tests := []struct {
+		name    string
+		results []result.Result[int]
+		want    result.Result[[]int]
+	}{
+		{
+			name:    "ok",
+			results: []result.Result[int]{result.OfOk(1), result.OfOk(2)},
+			want:    result.OfOk([]int{1, 2}),
+		},
+		{
+			name:    "error",
+			results: []result.Result[int]{result.OfOk(1), result.OfError[int](nil), result.OfError[int](errFail)},
+			want:    result.OfError[[]int](errFail),
+		},
+		{
+			name:    "nil error",
+			results: []result.Result[int]{result.OfOk(1), result.OfError[int](nil)},
+			want:    result.OfError[[]int](nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.CollectAll(tt.results); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CollectAll() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	res := result.CollectAll([]result.Result[int]{result.OfError[int](errFail), result.OfOk(1), result.OfError[int](nil), result.OfError[int](errOther)})
+	if want := []error{errFail, errOther}; !reflect.DeepEqual(res.Errors(), want) {
+		t.Errorf("CollectAll().Errors() = %v, want %v", res.Errors(), want)
+	}
+	if !res.ErrorIs(errFail) || !res.ErrorIs(errOther) {
+		t.Errorf("CollectAll().ErrorIs() = %v %v, want %v %v", res.ErrorIs(errFail), res.ErrorIs(errOther), true, true)
+	}
+}
+
+func TestPartition(t *testing.T) {
+	results := []result.Result[int]{
+		result.OfOk(1),
+		result.OfError[int](errFail),
+		result.OfError[int](nil),
+		result.OfOk(2),
+		result.OfError[int](errOther),
+	}
+	vals, errs := result.Partition(results)
+	if want := []int{1, 2}; !reflect.DeepEqual(vals, want) {
+		t.Errorf("Partition() vals = %v, want %v", vals, want)
+	}
+	if want := []error{errFail, errOther}; !reflect.DeepEqual(errs, want) {
+		t.Errorf("Partition() errs = %v, want %v", errs, want)
+	}
+}
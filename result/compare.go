@@ -0,0 +1,79 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"cmp"
+	"reflect"
+	"strings"
+)
+
+// Equal returns whether res and res2 both contain equal values or both contain the same error.
+// Errors are compared with ==, except that errors of a non-comparable type are never equal (like errors.Is).
+func Equal[T comparable](res, res2 Result[T]) bool {
+	return EqualFunc(res, res2, func(v, v2 T) bool { return v == v2 })
+}
+
+// EqualFunc is like Equal but uses eq to compare the underlying values.
+// eq is only called if both res and res2 are ok.
+func EqualFunc[T, T2 any](res Result[T], res2 Result[T2], eq func(T, T2) bool) bool {
+	if res.err != nil || res2.err != nil {
+		return sameError(res.err, res2.err)
+	}
+	return eq(res.v, res2.v)
+}
+
+// sameError returns whether err == err2 without panicking if the errors are of a non-comparable type,
+// in which case they're not the same.
+func sameError(err, err2 error) bool {
+	if err == nil || err2 == nil {
+		return err == err2
+	}
+	t := reflect.TypeOf(err)
+	return t == reflect.TypeOf(err2) && t.Comparable() && err == err2
+}
+
+// Compare returns -1 if res is less than res2, 0 if they're equal, and +1 if res is greater than res2.
+// A Result that is not ok is less than any ok Result.
+// Two Results that are not ok are ordered by their error messages, with OfError(nil) first.
+// Otherwise, the underlying values are compared with cmp.Compare.
+func Compare[T cmp.Ordered](res, res2 Result[T]) int {
+	return CompareFunc(res, res2, cmp.Compare[T])
+}
+
+// CompareFunc is like Compare but uses cmp to compare the underlying values.
+// cmp is only called if both res and res2 are ok.
+func CompareFunc[T, T2 any](res Result[T], res2 Result[T2], cmp func(T, T2) int) int {
+	switch {
+	case res.err != nil && res2.err != nil:
+		return compareErrors(res.Error(), res2.Error())
+	case res.err != nil:
+		return -1
+	case res2.err != nil:
+		return +1
+	}
+	return cmp(res.v, res2.v)
+}
+
+// compareErrors orders errors by their messages, with nil first.
+func compareErrors(err, err2 error) int {
+	switch {
+	case err == nil && err2 == nil:
+		return 0
+	case err == nil:
+		return -1
+	case err2 == nil:
+		return +1
+	}
+	return strings.Compare(err.Error(), err2.Error())
+}
+
+// Less returns whether res is less than res2 according to Compare.
+// This is useful with sort.Slice:
+//
+//	sort.Slice(s, func(i, j int) bool { return result.Less(s[i], s[j]) })
+func Less[T cmp.Ordered](res, res2 Result[T]) bool {
+	return Compare(res, res2) < 0
+}
@@ -0,0 +1,92 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+// sliceError is an error of a non-comparable type.
+type sliceError []string
+
+func (err sliceError) Error() string {
+	return "slice error"
+}
+
+func TestEqual(t *testing.T) {
+	tests := []struct {
+		name string
+		res  result.Result[int]
+		res2 result.Result[int]
+		want bool
+	}{
+		{name: "same error", res: result.OfError[int](errFail), res2: result.OfError[int](errFail), want: true},
+		{name: "different errors", res: result.OfError[int](errFail), res2: result.OfError[int](errOther), want: false},
+		{name: "nil errors", res: result.OfError[int](nil), res2: result.OfError[int](nil), want: true},
+		{name: "non-comparable errors", res: result.OfError[int](sliceError{"a"}), res2: result.OfError[int](sliceError{"a"}), want: false},
+		{name: "non-comparable error", res: result.OfError[int](sliceError{"a"}), res2: result.OfError[int](errFail), want: false},
+		{name: "error", res: result.OfError[int](errFail), res2: result.OfOk(0), want: false},
+		{name: "error 2", res: result.OfOk(0), res2: result.OfError[int](nil), want: false},
+		{name: "equal", res: result.OfOk(1), res2: result.OfOk(1), want: true},
+		{name: "not equal", res: result.OfOk(1), res2: result.OfOk(2), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.Equal(tt.res, tt.res2); got != tt.want {
+				t.Errorf("Equal() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEqualFunc(t *testing.T) {
+	if !result.EqualFunc(result.OfOk([]int{1}), result.OfOk([]int{1}), slices.Equal[[]int]) {
+		t.Errorf("EqualFunc() = %v, want %v", false, true)
+	}
+	if result.EqualFunc(result.OfOk([]int{1}), result.OfOk([]int{2}), slices.Equal[[]int]) {
+		t.Errorf("EqualFunc() = %v, want %v", true, false)
+	}
+}
+
+func TestCompare(t *testing.T) {
+	tests := []struct {
+		name string
+		res  result.Result[int]
+		res2 result.Result[int]
+		want int
+	}{
+		{name: "same error", res: result.OfError[int](errFail), res2: result.OfError[int](errFail), want: 0},
+		{name: "errors", res: result.OfError[int](errFail), res2: result.OfError[int](errOther), want: -1},
+		{name: "nil error", res: result.OfError[int](nil), res2: result.OfError[int](errFail), want: -1},
+		{name: "nil error 2", res: result.OfError[int](errFail), res2: result.OfError[int](nil), want: +1},
+		{name: "error", res: result.OfError[int](errFail), res2: result.OfOk(0), want: -1},
+		{name: "error 2", res: result.OfOk(0), res2: result.OfError[int](errFail), want: +1},
+		{name: "less", res: result.OfOk(1), res2: result.OfOk(2), want: -1},
+		{name: "equal", res: result.OfOk(1), res2: result.OfOk(1), want: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.Compare(tt.res, tt.res2); got != tt.want {
+				t.Errorf("Compare() = %v, want %v", got, tt.want)
+			}
+			if got := result.Less(tt.res, tt.res2); got != (tt.want < 0) {
+				t.Errorf("Less() = %v, want %v", got, tt.want < 0)
+			}
+		})
+	}
+}
+
+func TestLess(t *testing.T) {
+	s := []result.Result[int]{result.OfOk(2), result.OfError[int](errOther), result.OfOk(1), result.OfError[int](errFail)}
+	sort.Slice(s, func(i, j int) bool { return result.Less(s[i], s[j]) })
+	want := []result.Result[int]{result.OfError[int](errFail), result.OfError[int](errOther), result.OfOk(1), result.OfOk(2)}
+	if !slices.Equal(s, want) {
+		t.Errorf("sorted = %v, want %v", s, want)
+	}
+}
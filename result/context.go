@@ -0,0 +1,152 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"runtime"
+)
+
+// Field is a key-value pair that provides structured context for an error.
+type Field struct {
+	Key   string
+	Value any
+}
+
+// ContextError is an error that wraps another error with a message, fields, and/or a stack trace.
+//
+// It's formatted as follows:
+//
+//	%s  the error message
+//	%v  the error message followed by the fields as key=value
+//	%+v like %v followed by the stack trace
+type ContextError struct {
+	err    error
+	msg    string
+	fields []Field
+	stack  []uintptr
+}
+
+// Error returns the message followed by the message of the wrapped error.
+func (e *ContextError) Error() string {
+	if e.msg == "" {
+		return e.err.Error()
+	}
+	return e.msg + ": " + e.err.Error()
+}
+
+// Unwrap returns the wrapped error.
+func (e *ContextError) Unwrap() error {
+	return e.err
+}
+
+// Fields returns the fields of e and of any ContextError it wraps, in the order they were added.
+func (e *ContextError) Fields() []Field {
+	var fields []Field
+	var inner *ContextError
+	if errors.As(e.err, &inner) {
+		fields = inner.Fields()
+	}
+	return append(fields, e.fields...)
+}
+
+// Stack returns the stack trace recorded by WithStack.
+// Returns the stack trace of a wrapped ContextError if e does not have one,
+// or nil if none of them do.
+func (e *ContextError) Stack() []runtime.Frame {
+	if e.stack == nil {
+		var inner *ContextError
+		if errors.As(e.err, &inner) {
+			return inner.Stack()
+		}
+		return nil
+	}
+	var s []runtime.Frame
+	frames := runtime.CallersFrames(e.stack)
+	for {
+		frame, more := frames.Next()
+		s = append(s, frame)
+		if !more {
+			return s
+		}
+	}
+}
+
+// Format implements fmt.Formatter.
+// The %v verb adds the fields, and %+v also the stack trace.
+// Other verbs are applied to the error message.
+func (e *ContextError) Format(s fmt.State, verb rune) {
+	switch verb {
+	case 'v':
+		io.WriteString(s, e.Error())
+		for _, f := range e.Fields() {
+			fmt.Fprintf(s, " %s=%v", f.Key, f.Value)
+		}
+		if s.Flag('+') {
+			for _, frame := range e.Stack() {
+				fmt.Fprintf(s, "\n%s\n\t%s:%d", frame.Function, frame.File, frame.Line)
+			}
+		}
+	default:
+		fmt.Fprintf(s, fmt.FormatString(s, verb), e.Error())
+	}
+}
+
+// contextError returns a copy of the underlying error if it's a *ContextError.
+// Otherwise, returns a new ContextError that wraps the underlying error.
+func (res Result[T]) contextError() *ContextError {
+	if e, ok := res.err.(*ContextError); ok {
+		c := *e
+		// don't share the backing array
+		c.fields = c.fields[:len(c.fields):len(c.fields)]
+		return &c
+	}
+	return &ContextError{err: res.err}
+}
+
+// Wrap returns a Result where the contained error has been wrapped with msg.
+// Does nothing if res does not contain an error.
+func (res Result[T]) Wrap(msg string) Result[T] {
+	if res.IsError() {
+		res.err = &ContextError{err: res.err, msg: msg}
+	}
+	return res
+}
+
+// WithField returns a Result where the contained error has been annotated with the given key-value pair.
+// Does nothing if res does not contain an error.
+func (res Result[T]) WithField(key string, value any) Result[T] {
+	if res.IsError() {
+		e := res.contextError()
+		e.fields = append(e.fields, Field{Key: key, Value: value})
+		res.err = e
+	}
+	return res
+}
+
+// WithStack returns a Result where the contained error has been annotated with the current stack trace.
+// Does nothing if res does not contain an error.
+func (res Result[T]) WithStack() Result[T] {
+	if res.IsError() {
+		e := res.contextError()
+		e.stack = make([]uintptr, 32)
+		// skip runtime.Callers and WithStack
+		e.stack = e.stack[:runtime.Callers(2, e.stack)]
+		res.err = e
+	}
+	return res
+}
+
+// Fields returns the fields that annotate the contained error, in the order they were added.
+// Returns nil if res does not contain an error or the error does not have fields.
+func (res Result[T]) Fields() []Field {
+	var e *ContextError
+	if !res.ErrorAs(&e) {
+		return nil
+	}
+	return e.Fields()
+}
@@ -0,0 +1,110 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+func ExampleResult_WithField() {
+	res := result.Of(leaf(true)).
+		WithField("id", 42).
+		Wrap("load failed").
+		WithField("attempt", 1)
+	fmt.Println(res.Error().Error())
+	fmt.Println(res.Fields())
+	fmt.Println(res)
+	// Output:
+	// load failed: fail
+	// [{id 42} {attempt 1}]
+	// Err(load failed: fail id=42 attempt=1)
+}
+
+func TestResult_Wrap(t *testing.T) {
+	if got := result.OfOk(1).Wrap("foo"); got != result.OfOk(1) {
+		t.Errorf("Wrap() = %v, want %v", got, result.OfOk(1))
+	}
+	if got := result.OfError[int](nil).Wrap("foo"); got != result.OfError[int](nil) {
+		t.Errorf("Wrap() = %v, want %v", got, result.OfError[int](nil))
+	}
+	got := result.OfError[int](errFail).Wrap("foo").Wrap("bar")
+	if got.Error().Error() != "bar: foo: fail" {
+		t.Errorf("Wrap().Error() = %v, want %v", got.Error(), "bar: foo: fail")
+	}
+	if !got.ErrorIs(errFail) {
+		t.Errorf("Wrap().ErrorIs() = %v, want %v", false, true)
+	}
+	if got.Fields() != nil {
+		t.Errorf("Wrap().Fields() = %v, want %v", got.Fields(), nil)
+	}
+}
+
+func TestResult_WithField(t *testing.T) {
+	if got := result.OfOk(1).WithField("foo", "bar"); got != result.OfOk(1) {
+		t.Errorf("WithField() = %v, want %v", got, result.OfOk(1))
+	}
+	if got := result.OfError[int](errFail).Fields(); got != nil {
+		t.Errorf("Fields() = %v, want %v", got, nil)
+	}
+
+	base := result.OfError[int](errFail).WithField("a", 1)
+	res1 := base.WithField("b", 2)
+	res2 := base.WithField("c", 3)
+	if want := []result.Field{{Key: "a", Value: 1}}; !reflect.DeepEqual(base.Fields(), want) {
+		t.Errorf("Fields() = %v, want %v", base.Fields(), want)
+	}
+	if want := []result.Field{{Key: "a", Value: 1}, {Key: "b", Value: 2}}; !reflect.DeepEqual(res1.Fields(), want) {
+		t.Errorf("Fields() = %v, want %v", res1.Fields(), want)
+	}
+	if want := []result.Field{{Key: "a", Value: 1}, {Key: "c", Value: 3}}; !reflect.DeepEqual(res2.Fields(), want) {
+		t.Errorf("Fields() = %v, want %v", res2.Fields(), want)
+	}
+	if res1.Error().Error() != "fail" {
+		t.Errorf("WithField().Error() = %v, want %v", res1.Error(), "fail")
+	}
+
+	// fields survive Errorf
+	wrapped := res1.Errorf("outer: %w")
+	if want := res1.Fields(); !reflect.DeepEqual(wrapped.Fields(), want) {
+		t.Errorf("Fields() = %v, want %v", wrapped.Fields(), want)
+	}
+}
+
+func TestResult_WithStack(t *testing.T) {
+	if got := result.OfOk(1).WithStack(); got != result.OfOk(1) {
+		t.Errorf("WithStack() = %v, want %v", got, result.OfOk(1))
+	}
+
+	res := result.OfError[int](errFail).WithStack().Wrap("foo")
+	var e *result.ContextError
+	if !res.ErrorAs(&e) {
+		t.Fatalf("ErrorAs() = %v, want %v", false, true)
+	}
+	stack := e.Stack()
+	if len(stack) == 0 || !strings.HasSuffix(stack[0].Function, "TestResult_WithStack") {
+		t.Errorf("Stack() = %v, want TestResult_WithStack first", stack)
+	}
+
+	if got := fmt.Sprintf("%v", e); got != "foo: fail" {
+		t.Errorf("Sprintf(%%v) = %v, want %v", got, "foo: fail")
+	}
+	got := fmt.Sprintf("%+v", res.WithField("id", 1).Error())
+	if !strings.HasPrefix(got, "foo: fail id=1\n") || !strings.Contains(got, "context_test.go") {
+		t.Errorf("Sprintf(%%+v) = %v, want message, fields, and stack", got)
+	}
+	if got := fmt.Sprintf("%s", res.WithField("id", 1).Error()); got != "foo: fail" {
+		t.Errorf("Sprintf(%%s) = %v, want %v", got, "foo: fail")
+	}
+	for format, want := range map[string]string{"%q": `"foo: fail"`, "%x": "666f6f3a206661696c", "%11s": "  foo: fail"} {
+		if got := fmt.Sprintf(format, res.WithField("id", 1).Error()); got != want {
+			t.Errorf("Sprintf(%q) = %v, want %v", format, got, want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"strings"
+)
+
+// MultiError is an error that contains multiple errors.
+// errors.Is and errors.As (and therefore ErrorIs and ErrorAs) match any of the errors.
+type MultiError struct {
+	errs []error
+}
+
+// Error returns the messages of the errors separated by newlines.
+func (e *MultiError) Error() string {
+	var b strings.Builder
+	for i, err := range e.errs {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(err.Error())
+	}
+	return b.String()
+}
+
+// Errors returns the errors.
+func (e *MultiError) Errors() []error {
+	return e.errs
+}
+
+// Unwrap returns the errors.
+// This enables errors.Is and errors.As to match any of the errors.
+func (e *MultiError) Unwrap() []error {
+	return e.errs
+}
+
+// joinErrors returns an error that contains the non-nil errs.
+// The errors of a *MultiError are flattened into the result.
+// Returns nil if there are no non-nil errs and the only error if there is one.
+func joinErrors(errs ...error) error {
+	var joined []error
+	for _, err := range errs {
+		switch err := err.(type) {
+		case nil:
+		case *MultiError:
+			joined = append(joined, err.errs...)
+		default:
+			joined = append(joined, err)
+		}
+	}
+	switch len(joined) {
+	case 0:
+		return nil
+	case 1:
+		return joined[0]
+	}
+	return &MultiError{errs: joined}
+}
+
+// Errors returns the errors contained in res.
+// Returns the errors of the underlying error if it's a *MultiError,
+// the underlying error if it's any other error,
+// or nil if res does not contain an error.
+func (res Result[T]) Errors() []error {
+	if !res.IsError() {
+		return nil
+	}
+	if e, ok := res.err.(*MultiError); ok {
+		return e.Errors()
+	}
+	return []error{res.err}
+}
+
+// ZipWith calls f with the underlying values of res and res2 and returns a Result of the return value.
+// Returns a Result of the errors of both res and res2 joined as a *MultiError if either contains an error.
+// Unlike FlatMap, the error of res2 is not discarded when res contains an error.
+func ZipWith[T, T2, T3 any](res Result[T], res2 Result[T2], f func(T, T2) T3) Result[T3] {
+	if res.err != nil || res2.err != nil {
+		return OfError[T3](joinErrors(res.Error(), res2.Error()))
+	}
+	return OfOk(f(res.v, res2.v))
+}
+
+// ZipWith3 calls f with the underlying values of res, res2 and res3 and returns a Result of the return value.
+// Returns a Result of the errors of all of res, res2 and res3 joined as a *MultiError if any contains an error.
+func ZipWith3[T, T2, T3, T4 any](res Result[T], res2 Result[T2], res3 Result[T3], f func(T, T2, T3) T4) Result[T4] {
+	if res.err != nil || res2.err != nil || res3.err != nil {
+		return OfError[T4](joinErrors(res.Error(), res2.Error(), res3.Error()))
+	}
+	return OfOk(f(res.v, res2.v, res3.v))
+}
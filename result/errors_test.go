@@ -0,0 +1,151 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+type form struct {
+	Name string
+	Age  int
+}
+
+func validateName(s string) result.Result[string] {
+	if s == "" {
+		return result.OfError[string](errors.New("name is required"))
+	}
+	return result.OfOk(s)
+}
+
+func validateAge(s string) result.Result[int] {
+	return result.Of(strconv.Atoi(s)).Errorf("age is invalid: %w")
+}
+
+// ExampleZipWith demonstrates that ZipWith accumulates the errors of every field.
+func ExampleZipWith() {
+	newForm := func(name string, age int) form { return form{Name: name, Age: age} }
+	res := result.ZipWith(validateName(""), validateAge("foo"), newForm)
+	for _, err := range res.Errors() {
+		fmt.Println(err)
+	}
+	fmt.Println(res.ErrorIs(strconv.ErrSyntax))
+
+	res = result.ZipWith(validateName("foo"), validateAge("42"), newForm)
+	fmt.Println(res.Value().MustOk())
+	// Output:
+	// name is required
+	// age is invalid: strconv.Atoi: parsing "foo": invalid syntax
+	// true
+	// {foo 42}
+}
+
+func TestZipWith(t *testing.T) {
+	add := func(a, b int) int { return a + b }
+	tests := []struct {
+		name       string
+		res        result.Result[int]
+		res2       result.Result[int]
+		want       result.Result[int]
+		wantErrors []error
+	}{
+		{
+			name: "ok",
+			res:  result.OfOk(1),
+			res2: result.OfOk(2),
+			want: result.OfOk(3),
+		},
+		{
+			name:       "first error",
+			res:        result.OfError[int](errFail),
+			res2:       result.OfOk(2),
+			want:       result.OfError[int](errFail),
+			wantErrors: []error{errFail},
+		},
+		{
+			name:       "second error",
+			res:        result.OfOk(1),
+			res2:       result.OfError[int](errOther),
+			want:       result.OfError[int](errOther),
+			wantErrors: []error{errOther},
+		},
+		{
+			name:       "both errors",
+			res:        result.OfError[int](errFail),
+			res2:       result.OfError[int](errOther),
+			wantErrors: []error{errFail, errOther},
+		},
+		{
+			name: "nil error",
+			res:  result.OfError[int](nil),
+			res2: result.OfOk(2),
+			want: result.OfError[int](nil),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := result.ZipWith(tt.res, tt.res2, add)
+			if len(tt.wantErrors) < 2 && got != tt.want {
+				t.Errorf("ZipWith() = %v, want %v", got, tt.want)
+			}
+			if !reflect.DeepEqual(got.Errors(), tt.wantErrors) {
+				t.Errorf("ZipWith().Errors() = %v, want %v", got.Errors(), tt.wantErrors)
+			}
+			for _, err := range tt.wantErrors {
+				if !got.ErrorIs(err) {
+					t.Errorf("ZipWith().ErrorIs(%v) = %v, want %v", err, false, true)
+				}
+			}
+		})
+	}
+}
+
+func TestZipWith3(t *testing.T) {
+	sum := func(a, b, c int) int { return a + b + c }
+	if got := result.ZipWith3(result.OfOk(1), result.OfOk(2), result.OfOk(3), sum); got != result.OfOk(6) {
+		t.Errorf("ZipWith3() = %v, want %v", got, result.OfOk(6))
+	}
+
+	// nested MultiError is flattened
+	pathErr := &fs.PathError{Op: "open", Path: "/foo/bar", Err: fs.ErrNotExist}
+	res12 := result.ZipWith(result.OfError[int](errFail), result.OfError[int](errOther), func(a, b int) int { return a + b })
+	got := result.ZipWith3(result.OfOk(1), result.OfError[int](pathErr), res12, sum)
+	if want := []error{pathErr, errFail, errOther}; !reflect.DeepEqual(got.Errors(), want) {
+		t.Errorf("ZipWith3().Errors() = %v, want %v", got.Errors(), want)
+	}
+	var target *fs.PathError
+	if !got.ErrorAs(&target) || target != pathErr {
+		t.Errorf("ZipWith3().ErrorAs() = %v, want %v", target, pathErr)
+	}
+	if !got.ErrorIs(fs.ErrNotExist) {
+		t.Errorf("ZipWith3().ErrorIs() = %v, want %v", false, true)
+	}
+	if want := fmt.Sprintf("%v\nfail\nother", pathErr); got.Error().Error() != want {
+		t.Errorf("ZipWith3().Error() = %v, want %v", got.Error(), want)
+	}
+	var multi *result.MultiError
+	if !got.ErrorAs(&multi) || len(multi.Errors()) != 3 {
+		t.Errorf("ZipWith3().ErrorAs() = %v, want *MultiError of 3 errors", multi)
+	}
+}
+
+func TestResult_Errors(t *testing.T) {
+	if got := result.OfOk(1).Errors(); got != nil {
+		t.Errorf("Errors() = %v, want %v", got, nil)
+	}
+	if got := result.OfError[int](nil).Errors(); got != nil {
+		t.Errorf("Errors() = %v, want %v", got, nil)
+	}
+	if got, want := result.OfError[int](errFail).Errors(), []error{errFail}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Errors() = %v, want %v", got, want)
+	}
+}
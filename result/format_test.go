@@ -0,0 +1,75 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+// type checks
+var (
+	_ fmt.Formatter  = result.Result[int]{}
+	_ fmt.GoStringer = result.Result[int]{}
+)
+
+func ExampleResult_Format() {
+	res := result.OfError[int](errFail).WithField("id", 42)
+	fmt.Printf("%v\n%#v\n", result.OfOk(42), result.OfOk(42))
+	fmt.Printf("%s\n%v\n%#v\n", res, res, res)
+	// Output:
+	// Ok(42)
+	// result.OfOk[int](42)
+	// Err(fail)
+	// Err(fail id=42)
+	// result.OfError[int](errors.New("fail"))
+}
+
+func TestResult_Format(t *testing.T) {
+	tests := []struct {
+		name   string
+		format string
+		res    any
+		want   string
+	}{
+		{name: "v ok", format: "%v", res: result.OfOk(42), want: "Ok(42)"},
+		{name: "v error", format: "%v", res: result.OfError[int](errFail), want: "Err(fail)"},
+		{name: "v nil error", format: "%v", res: result.OfError[int](nil), want: "Err(<nil>)"},
+		{name: "q", format: "%q", res: result.OfOk("foo"), want: `Ok("foo")`},
+		{name: "width", format: "%8v", res: result.OfOk(42), want: "  Ok(42)"},
+		{name: "left", format: "%-8v|", res: result.OfError[int](errFail), want: "Err(fail)|"},
+		{name: "go ok", format: "%#v", res: result.OfOk("foo"), want: `result.OfOk[string]("foo")`},
+		{name: "go nil error", format: "%#v", res: result.OfError[int](nil), want: "result.OfError[int](nil)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := fmt.Sprintf(tt.format, tt.res); got != tt.want {
+				t.Errorf("Sprintf(%q) = %v, want %v", tt.format, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Format_stack(t *testing.T) {
+	res := result.OfError[int](errFail).WithStack()
+	if got := fmt.Sprintf("%+v", res); !strings.HasPrefix(got, "Err(fail\n") || !strings.Contains(got, "TestResult_Format_stack") {
+		t.Errorf("Sprintf() = %v, want stack trace", got)
+	}
+}
+
+func TestResult_Format_bare(t *testing.T) {
+	if got := fmt.Sprint(result.OfOk(42).Bare()); got != "42" {
+		t.Errorf("Sprint() = %v, want %v", got, "42")
+	}
+	if got := fmt.Sprint(result.OfError[int](errFail).Bare()); got != "fail" {
+		t.Errorf("Sprint() = %v, want %v", got, "fail")
+	}
+	if got := fmt.Sprintf("%-4v|", result.OfOk(42).Bare()); got != "42  |" {
+		t.Errorf("Sprintf() = %v, want %v", got, "42  |")
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"context"
+	"errors"
+)
+
+// Future is a Result that becomes available asynchronously.
+type Future[T any] struct {
+	res    Result[T]
+	done   chan struct{}
+	cancel context.CancelFunc
+}
+
+// Go calls f in a new goroutine and returns a Future of its return values.
+// A panic in f is recovered and contained in the Result as a *PanicError.
+func Go[T any](f func() (T, error)) *Future[T] {
+	return GoContext(context.Background(), func(context.Context) (T, error) {
+		return f()
+	})
+}
+
+// GoContext calls f in a new goroutine with a context derived from ctx
+// and returns a Future of its return values.
+// The context is canceled when f returns or the Future is canceled.
+// A panic in f is recovered and contained in the Result as a *PanicError.
+func GoContext[T any](ctx context.Context, f func(context.Context) (T, error)) *Future[T] {
+	ctx, cancel := context.WithCancel(ctx)
+	fut := &Future[T]{done: make(chan struct{}), cancel: cancel}
+	go fut.run(ctx, f)
+	return fut
+}
+
+func (fut *Future[T]) run(ctx context.Context, f func(context.Context) (T, error)) {
+	defer close(fut.done)
+	defer fut.cancel()
+	fut.res = Try(func() (T, error) {
+		return f(ctx)
+	})
+}
+
+// Done returns a channel that is closed when the Result is available.
+func (fut *Future[T]) Done() <-chan struct{} {
+	return fut.done
+}
+
+// Cancel cancels the context of the underlying function.
+// The Result is still available once the function returns.
+func (fut *Future[T]) Cancel() {
+	fut.cancel()
+}
+
+// Await blocks until the Result is available and returns it.
+func (fut *Future[T]) Await() Result[T] {
+	<-fut.done
+	return fut.res
+}
+
+// AwaitContext blocks until the Result is available or ctx is done.
+// Returns a Result of ctx.Err() if ctx is done first.
+// Does not cancel the Future.
+func (fut *Future[T]) AwaitContext(ctx context.Context) Result[T] {
+	select {
+	case <-fut.done:
+		return fut.res
+	case <-ctx.Done():
+		return OfError[T](ctx.Err())
+	}
+}
+
+// All returns a Future of the underlying values of futs.
+// Returns a Result of the first error if any of futs fails.
+// The remaining futures are canceled when the returned Future is done.
+func All[T any](futs ...*Future[T]) *Future[[]T] {
+	return combine(futs, func(ctx context.Context, next func() (int, bool)) ([]T, error) {
+		s := make([]T, len(futs))
+		for range futs {
+			i, ok := next()
+			if !ok {
+				return nil, ctx.Err()
+			}
+			v, err := futs[i].res.Unpack()
+			if err != nil {
+				return nil, err
+			}
+			s[i] = v
+		}
+		return s, nil
+	})
+}
+
+// Any returns a Future of the underlying value of the first of futs to succeed.
+// Returns a Result of every error joined as a *MultiError if all of futs fail
+// or a Result of an error if futs is empty.
+// The remaining futures are canceled when the returned Future is done.
+func Any[T any](futs ...*Future[T]) *Future[T] {
+	return combine(futs, func(ctx context.Context, next func() (int, bool)) (T, error) {
+		if len(futs) == 0 {
+			var zero T
+			return zero, errors.New("result.Any: no futures")
+		}
+		errs := make([]error, len(futs))
+		for range futs {
+			i, ok := next()
+			if !ok {
+				var zero T
+				return zero, ctx.Err()
+			}
+			v, err := futs[i].res.Unpack()
+			if err == nil {
+				return v, nil
+			}
+			errs[i] = err
+		}
+		var zero T
+		return zero, joinErrors(errs...)
+	})
+}
+
+// Race returns a Future of the Result of the first of futs to be done.
+// Returns a Result of an error if futs is empty.
+// The remaining futures are canceled when the returned Future is done.
+func Race[T any](futs ...*Future[T]) *Future[T] {
+	return combine(futs, func(ctx context.Context, next func() (int, bool)) (T, error) {
+		if len(futs) == 0 {
+			var zero T
+			return zero, errors.New("result.Race: no futures")
+		}
+		i, ok := next()
+		if !ok {
+			var zero T
+			return zero, ctx.Err()
+		}
+		return futs[i].res.Unpack()
+	})
+}
+
+// Settled returns a Future of the Results of futs once all of them are done.
+// Canceling the returned Future cancels futs.
+func Settled[T any](futs ...*Future[T]) *Future[[]Result[T]] {
+	return combine(futs, func(ctx context.Context, next func() (int, bool)) ([]Result[T], error) {
+		s := make([]Result[T], len(futs))
+		for range futs {
+			i, ok := next()
+			if !ok {
+				return nil, ctx.Err()
+			}
+			s[i] = futs[i].res
+		}
+		return s, nil
+	})
+}
+
+// combine returns a Future of the return values of f.
+// f calls next to wait for the index of the next of futs to be done;
+// next returns false if the returned Future is canceled first.
+// futs are canceled when f returns.
+func combine[T, T2 any](futs []*Future[T], f func(ctx context.Context, next func() (int, bool)) (T2, error)) *Future[T2] {
+	return GoContext(context.Background(), func(ctx context.Context) (T2, error) {
+		defer func() {
+			for _, fut := range futs {
+				fut.Cancel()
+			}
+		}()
+		// buffered so that no goroutine blocks if f returns early
+		ch := make(chan int, len(futs))
+		for i, fut := range futs {
+			go func(i int, fut *Future[T]) {
+				<-fut.done
+				ch <- i
+			}(i, fut)
+		}
+		next := func() (int, bool) {
+			select {
+			case i := <-ch:
+				return i, true
+			case <-ctx.Done():
+				return 0, false
+			}
+		}
+		return f(ctx, next)
+	})
+}
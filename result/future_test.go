@@ -0,0 +1,210 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+// wait returns a function that returns v and err once ch is closed
+// or the error of ctx if it's canceled first.
+func wait[T any](ch <-chan struct{}, v T, err error) func(context.Context) (T, error) {
+	return func(ctx context.Context) (T, error) {
+		select {
+		case <-ch:
+			return v, err
+		case <-ctx.Done():
+			var zero T
+			return zero, ctx.Err()
+		}
+	}
+}
+
+func ExampleAll() {
+	square := func(i int) func() (int, error) {
+		return func() (int, error) { return i * i, nil }
+	}
+	res := result.All(result.Go(square(1)), result.Go(square(2)), result.Go(square(3))).Await()
+	fmt.Println(res.Value().MustOk())
+	// Output: [1 4 9]
+}
+
+func TestGo(t *testing.T) {
+	if got := result.Go(func() (int, error) { return 1, nil }).Await(); got != result.OfOk(1) {
+		t.Errorf("Await() = %v, want %v", got, result.OfOk(1))
+	}
+	if got := result.Go(func() (int, error) { return 0, errFail }).Await(); got != result.OfError[int](errFail) {
+		t.Errorf("Await() = %v, want %v", got, result.OfError[int](errFail))
+	}
+}
+
+func TestGo_panic(t *testing.T) {
+	res := result.Go(func() (int, error) { panic(errFail) }).Await()
+	var perr *result.PanicError
+	if !res.ErrorAs(&perr) {
+		t.Fatalf("ErrorAs() = %v, want %v", false, true)
+	}
+	if perr.Value != errFail || len(perr.Stack) == 0 {
+		t.Errorf("PanicError = %v %s, want %v with stack", perr.Value, perr.Stack, errFail)
+	}
+	if !res.ErrorIs(errFail) {
+		t.Errorf("ErrorIs() = %v, want %v", false, true)
+	}
+	if got := res.Error().Error(); got != "panic: fail" {
+		t.Errorf("Error() = %v, want %v", got, "panic: fail")
+	}
+}
+
+func TestFuture_Cancel(t *testing.T) {
+	fut := result.GoContext(context.Background(), wait(nil, 1, nil))
+	fut.Cancel()
+	if got := fut.Await(); got != result.OfError[int](context.Canceled) {
+		t.Errorf("Await() = %v, want %v", got, result.OfError[int](context.Canceled))
+	}
+	select {
+	case <-fut.Done():
+	default:
+		t.Errorf("Done() is open after Await()")
+	}
+}
+
+func TestFuture_AwaitContext(t *testing.T) {
+	ch := make(chan struct{})
+	fut := result.GoContext(context.Background(), wait(ch, 1, nil))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	if got := fut.AwaitContext(ctx); got != result.OfError[int](context.DeadlineExceeded) {
+		t.Errorf("AwaitContext() = %v, want %v", got, result.OfError[int](context.DeadlineExceeded))
+	}
+	// future is not canceled
+	close(ch)
+	if got := fut.AwaitContext(context.Background()); got != result.OfOk(1) {
+		t.Errorf("AwaitContext() = %v, want %v", got, result.OfOk(1))
+	}
+}
+
+func TestAll(t *testing.T) {
+	ch := make(chan struct{})
+	close(ch)
+	block := make(chan struct{})
+
+	got := result.All(
+		result.GoContext(context.Background(), wait(ch, 1, nil)),
+		result.GoContext(context.Background(), wait(ch, 2, nil)),
+	).Await()
+	if !reflect.DeepEqual(got, result.OfOk([]int{1, 2})) {
+		t.Errorf("All() = %v, want %v", got, result.OfOk([]int{1, 2}))
+	}
+
+	blocked := result.GoContext(context.Background(), wait(block, 1, nil))
+	got = result.All(
+		blocked,
+		result.GoContext(context.Background(), wait(ch, 2, errFail)),
+	).Await()
+	if !reflect.DeepEqual(got, result.OfError[[]int](errFail)) {
+		t.Errorf("All() = %v, want %v", got, result.OfError[[]int](errFail))
+	}
+	if got := blocked.Await(); got != result.OfError[int](context.Canceled) {
+		t.Errorf("remaining Await() = %v, want %v", got, result.OfError[int](context.Canceled))
+	}
+
+	// cancel propagates
+	blocked = result.GoContext(context.Background(), wait(block, 1, nil))
+	all := result.All(blocked)
+	all.Cancel()
+	if got := all.Await(); !reflect.DeepEqual(got, result.OfError[[]int](context.Canceled)) {
+		t.Errorf("All() = %v, want %v", got, result.OfError[[]int](context.Canceled))
+	}
+	if got := blocked.Await(); got != result.OfError[int](context.Canceled) {
+		t.Errorf("remaining Await() = %v, want %v", got, result.OfError[int](context.Canceled))
+	}
+}
+
+func TestAny(t *testing.T) {
+	ch := make(chan struct{})
+	close(ch)
+	block := make(chan struct{})
+
+	blocked := result.GoContext(context.Background(), wait(block, 1, nil))
+	got := result.Any(
+		blocked,
+		result.GoContext(context.Background(), wait(ch, 0, errFail)),
+		result.GoContext(context.Background(), wait(ch, 3, nil)),
+	).Await()
+	if got != result.OfOk(3) {
+		t.Errorf("Any() = %v, want %v", got, result.OfOk(3))
+	}
+	if got := blocked.Await(); got != result.OfError[int](context.Canceled) {
+		t.Errorf("remaining Await() = %v, want %v", got, result.OfError[int](context.Canceled))
+	}
+
+	got = result.Any(
+		result.GoContext(context.Background(), wait(ch, 0, errFail)),
+		result.GoContext(context.Background(), wait(ch, 0, errOther)),
+	).Await()
+	if !got.ErrorIs(errFail) || !got.ErrorIs(errOther) {
+		t.Errorf("Any() = %v, want errors %v and %v", got, errFail, errOther)
+	}
+
+	if got = result.Any[int]().Await(); !got.IsError() {
+		t.Errorf("Any() = %v, want error", got)
+	}
+}
+
+func TestRace(t *testing.T) {
+	ch := make(chan struct{})
+	close(ch)
+	block := make(chan struct{})
+
+	blocked := result.GoContext(context.Background(), wait(block, 1, nil))
+	got := result.Race(
+		blocked,
+		result.GoContext(context.Background(), wait(ch, 0, errFail)),
+	).Await()
+	if got != result.OfError[int](errFail) {
+		t.Errorf("Race() = %v, want %v", got, result.OfError[int](errFail))
+	}
+	if got := blocked.Await(); got != result.OfError[int](context.Canceled) {
+		t.Errorf("remaining Await() = %v, want %v", got, result.OfError[int](context.Canceled))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	if got := result.Race[int]().AwaitContext(ctx); !got.IsError() || errors.Is(got.Error(), context.DeadlineExceeded) {
+		t.Errorf("Race() = %v, want error for no futures", got)
+	}
+}
+
+func TestSettled(t *testing.T) {
+	ch := make(chan struct{})
+	close(ch)
+
+	got := result.Settled(
+		result.GoContext(context.Background(), wait(ch, 1, nil)),
+		result.GoContext(context.Background(), wait(ch, 0, errFail)),
+		result.Go(func() (int, error) { panic("boom") }),
+	).Await()
+	rs, err := got.Unpack()
+	if err != nil || len(rs) != 3 {
+		t.Fatalf("Settled() = %v, want 3 Results", got)
+	}
+	if rs[0] != result.OfOk(1) || rs[1] != result.OfError[int](errFail) {
+		t.Errorf("Settled() = %v, want %v", rs[:2], []result.Result[int]{result.OfOk(1), result.OfError[int](errFail)})
+	}
+	var perr *result.PanicError
+	if !rs[2].ErrorAs(&perr) || perr.Value != "boom" {
+		t.Errorf("Settled()[2] = %v, want %v", rs[2], "panic: boom")
+	}
+	if errors.Unwrap(perr) != nil {
+		t.Errorf("Unwrap() = %v, want %v", errors.Unwrap(perr), nil)
+	}
+}
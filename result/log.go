@@ -0,0 +1,17 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import "log/slog"
+
+// LogValue implements the slog.LogValuer interface.
+// Returns a group with either a "value" key of the underlying value
+// or an "error" key of the underlying error.
+func (res Result[T]) LogValue() slog.Value {
+	if res.err != nil {
+		return slog.GroupValue(slog.Any("error", res.Error()))
+	}
+	return slog.GroupValue(slog.Any("value", res.v))
+}
@@ -0,0 +1,44 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"bytes"
+	"log/slog"
+	"os"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+// type checks
+var _ slog.LogValuer = result.Result[int]{}
+
+// removeTime removes the time attribute so that output is deterministic.
+func removeTime(groups []string, a slog.Attr) slog.Attr {
+	if a.Key == slog.TimeKey && len(groups) == 0 {
+		return slog.Attr{}
+	}
+	return a
+}
+
+func ExampleResult_LogValue() {
+	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+	logger.Info("write", "n", result.OfOk(3), "err", result.OfError[int](errFail))
+	// Output: {"level":"INFO","msg":"write","n":{"value":3},"err":{"error":"fail"}}
+}
+
+func TestResult_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{ReplaceAttr: removeTime}))
+	logger.Info("msg",
+		"ok", result.OfOk("foo"),
+		"err", result.OfError[string](errFail),
+		"nil", result.OfError[string](nil),
+	)
+	if got, want := buf.String(), "level=INFO msg=msg ok.value=foo err.error=fail nil.error=<nil>\n"; got != want {
+		t.Errorf("log output = %q, want %q", got, want)
+	}
+}
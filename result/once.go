@@ -0,0 +1,52 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import "sync"
+
+// Once is a Result that is computed on first use and then cached.
+// It's safe for concurrent use; concurrent callers wait for the computation in progress.
+type Once[T any] struct {
+	f     func() (T, error)
+	retry func(error) bool
+	mu    sync.Mutex
+	done  bool
+	res   Result[T]
+}
+
+// OnceOf creates a Once of the return values of f.
+// f is called at most once, on the first call to Get.
+// A panic in f is recovered and contained in the Result as a *PanicError.
+func OnceOf[T any](f func() (T, error)) *Once[T] {
+	return &Once[T]{f: f}
+}
+
+// OnceOfRetry is like OnceOf except that an error is not cached if retry returns true for it.
+// Instead, the next call to Get calls f again.
+func OnceOfRetry[T any](f func() (T, error), retry func(error) bool) *Once[T] {
+	return &Once[T]{f: f, retry: retry}
+}
+
+// Get returns the cached Result, computing it first if needed.
+func (o *Once[T]) Get() Result[T] {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if o.done {
+		return o.res
+	}
+	res := Try(o.f)
+	if res.IsError() && o.retry != nil && o.retry(res.err) {
+		return res
+	}
+	o.res, o.done = res, true
+	return res
+}
+
+// Done returns whether the Result has been cached.
+func (o *Once[T]) Done() bool {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.done
+}
@@ -0,0 +1,122 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+func ExampleOnceOf() {
+	client := result.OnceOf(func() (string, error) {
+		fmt.Println("connect")
+		return "client", nil
+	})
+	fmt.Println(client.Get())
+	fmt.Println(client.Get())
+	// Output:
+	// connect
+	// Ok(client)
+	// Ok(client)
+}
+
+// failing returns a function that fails the first n calls
+// and a pointer to its number of calls.
+func failing(n int, err error) (func() (int, error), *int) {
+	var calls int
+	return func() (int, error) {
+		calls++
+		if calls <= n {
+			return 0, err
+		}
+		return calls, nil
+	}, &calls
+}
+
+func TestOnceOf(t *testing.T) {
+	f, calls := failing(0, nil)
+	once := result.OnceOf(f)
+	if once.Done() {
+		t.Errorf("Done() = %v, want %v", true, false)
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if got := once.Get(); got != result.OfOk(1) {
+				t.Errorf("Get() = %v, want %v", got, result.OfOk(1))
+			}
+		}()
+	}
+	wg.Wait()
+	if *calls != 1 || !once.Done() {
+		t.Errorf("calls after Get() = %v, want %v", *calls, 1)
+	}
+
+	// error is cached
+	f, calls = failing(1, errFail)
+	once = result.OnceOf(f)
+	for i := 0; i < 2; i++ {
+		if got := once.Get(); got != result.OfError[int](errFail) {
+			t.Errorf("Get() = %v, want %v", got, result.OfError[int](errFail))
+		}
+	}
+	if *calls != 1 {
+		t.Errorf("calls after Get() = %v, want %v", *calls, 1)
+	}
+
+	// panic is cached
+	var panics int
+	once = result.OnceOf(func() (int, error) { panics++; panic("boom") })
+	for i := 0; i < 2; i++ {
+		var perr *result.PanicError
+		if got := once.Get(); !got.ErrorAs(&perr) {
+			t.Errorf("Get() = %v, want *PanicError", got)
+		}
+	}
+	if panics != 1 {
+		t.Errorf("calls after Get() = %v, want %v", panics, 1)
+	}
+}
+
+func TestOnceOfRetry(t *testing.T) {
+	isFail := func(err error) bool { return errors.Is(err, errFail) }
+
+	f, calls := failing(2, errFail)
+	once := result.OnceOfRetry(f, isFail)
+	for i := 0; i < 2; i++ {
+		if got := once.Get(); got != result.OfError[int](errFail) {
+			t.Errorf("Get() = %v, want %v", got, result.OfError[int](errFail))
+		}
+		if once.Done() {
+			t.Errorf("Done() = %v, want %v", true, false)
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if got := once.Get(); got != result.OfOk(3) {
+			t.Errorf("Get() = %v, want %v", got, result.OfOk(3))
+		}
+	}
+	if *calls != 3 || !once.Done() {
+		t.Errorf("calls after Get() = %v, want %v", *calls, 3)
+	}
+
+	// non-retryable error is cached
+	f, calls = failing(2, errOther)
+	once = result.OnceOfRetry(f, isFail)
+	for i := 0; i < 2; i++ {
+		if got := once.Get(); got != result.OfError[int](errOther) {
+			t.Errorf("Get() = %v, want %v", got, result.OfError[int](errOther))
+		}
+	}
+	if *calls != 1 {
+		t.Errorf("calls after Get() = %v, want %v", *calls, 1)
+	}
+}
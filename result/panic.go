@@ -0,0 +1,65 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"fmt"
+	"runtime/debug"
+
+	"github.com/phelmkamp/valor/tuple/unit"
+)
+
+// PanicError is an error that contains a value recovered from a panic.
+type PanicError struct {
+	// Value is the value passed to panic.
+	Value any
+	// Stack is the stack trace of the goroutine that panicked.
+	Stack []byte
+}
+
+// newPanicError creates a PanicError of v and the current stack trace.
+// It must be called by the deferred function that recovered v.
+func newPanicError(v any) *PanicError {
+	return &PanicError{Value: v, Stack: debug.Stack()}
+}
+
+// Error returns the recovered value formatted as a string.
+func (e *PanicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.Value)
+}
+
+// Unwrap returns the recovered value if it's an error, nil otherwise.
+func (e *PanicError) Unwrap() error {
+	err, _ := e.Value.(error)
+	return err
+}
+
+// Try calls f and creates a Result of its return values.
+// A panic in f is recovered and contained in the Result as a *PanicError.
+func Try[T any](f func() (T, error)) (res Result[T]) {
+	defer func() {
+		if r := recover(); r != nil {
+			res = OfError[T](newPanicError(r))
+		}
+	}()
+	return Of(f())
+}
+
+// TryFunc calls f and creates a Result of its return value.
+// A panic in f is recovered and contained in the Result as a *PanicError.
+func TryFunc[T any](f func() T) Result[T] {
+	return Try(func() (T, error) {
+		return f(), nil
+	})
+}
+
+// TryError calls f and creates a Result of its returned error.
+// The Result contains unit.Unit if f returns nil.
+// A panic in f is recovered and contained in the Result as a *PanicError.
+func TryError(f func() error) Result[unit.Type] {
+	return Try(func() (unit.Type, error) {
+		return unit.Unit, f()
+	})
+}
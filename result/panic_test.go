@@ -0,0 +1,115 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+	"github.com/phelmkamp/valor/tuple/unit"
+)
+
+func ExampleTry() {
+	var m map[string]int
+	res := result.Try(func() (int, error) {
+		m["foo"] = 1
+		return len(m), nil
+	})
+	var perr *result.PanicError
+	if res.ErrorAs(&perr) {
+		fmt.Println(perr.Value)
+	}
+	// Output: assignment to entry in nil map
+}
+
+func TestTry(t *testing.T) {
+	tests := []struct {
+		name      string
+		f         func() (int, error)
+		want      result.Result[int]
+		wantPanic any
+	}{
+		{
+			name: "ok",
+			f:    func() (int, error) { return 1, nil },
+			want: result.OfOk(1),
+		},
+		{
+			name: "error",
+			f:    func() (int, error) { return 0, errFail },
+			want: result.OfError[int](errFail),
+		},
+		{
+			name:      "panic",
+			f:         func() (int, error) { panic("boom") },
+			wantPanic: "boom",
+		},
+		{
+			name:      "panic error",
+			f:         func() (int, error) { panic(errFail) },
+			wantPanic: errFail,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := result.Try(tt.f)
+			if tt.wantPanic == nil {
+				if got != tt.want {
+					t.Errorf("Try() = %v, want %v", got, tt.want)
+				}
+				return
+			}
+			var perr *result.PanicError
+			if !got.ErrorAs(&perr) {
+				t.Fatalf("Try() = %v, want *PanicError", got)
+			}
+			if perr.Value != tt.wantPanic {
+				t.Errorf("PanicError.Value = %v, want %v", perr.Value, tt.wantPanic)
+			}
+			if !strings.Contains(string(perr.Stack), "panic_test.go") {
+				t.Errorf("PanicError.Stack = %s, want caller", perr.Stack)
+			}
+			if got.Value().IsOk() {
+				t.Errorf("Value().IsOk() = %v, want %v", true, false)
+			}
+		})
+	}
+}
+
+func TestTry_unwrap(t *testing.T) {
+	res := result.Try(func() (int, error) { panic(fmt.Errorf("wrapped: %w", errFail)) })
+	if !res.ErrorIs(errFail) {
+		t.Errorf("ErrorIs() = %v, want %v", false, true)
+	}
+	if got := res.ErrorUnwrap().Error().Error(); got != "wrapped: fail" {
+		t.Errorf("ErrorUnwrap() = %v, want %v", got, "wrapped: fail")
+	}
+}
+
+func TestTryFunc(t *testing.T) {
+	if got := result.TryFunc(func() string { return "foo" }); got != result.OfOk("foo") {
+		t.Errorf("TryFunc() = %v, want %v", got, result.OfOk("foo"))
+	}
+	var perr *result.PanicError
+	if got := result.TryFunc(func() string { panic("boom") }); !got.ErrorAs(&perr) || perr.Value != "boom" {
+		t.Errorf("TryFunc() = %v, want %v", got, "panic: boom")
+	}
+}
+
+func TestTryError(t *testing.T) {
+	if got := result.TryError(func() error { return nil }); got != result.OfOk(unit.Unit) {
+		t.Errorf("TryError() = %v, want %v", got, result.OfOk(unit.Unit))
+	}
+	if got := result.TryError(func() error { return errFail }); got != result.OfError[unit.Type](errFail) {
+		t.Errorf("TryError() = %v, want %v", got, result.OfError[unit.Type](errFail))
+	}
+	var perr *result.PanicError
+	if got := result.TryError(func() error { panic(errFail) }); !got.ErrorAs(&perr) || !errors.Is(perr, errFail) {
+		t.Errorf("TryError() = %v, want %v", got, "panic: fail")
+	}
+}
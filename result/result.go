@@ -7,7 +7,9 @@ package result
 import (
 	"errors"
 	"fmt"
+	"io"
 
+	"github.com/phelmkamp/valor/internal/format"
 	"github.com/phelmkamp/valor/optional"
 )
 
@@ -69,7 +71,60 @@ func (res Result[T]) IsError() bool {
 
 // String returns res formatted as a string.
 func (res Result[T]) String() string {
-	return fmt.Sprintf("{%v %v}", res.v, res.err)
+	return fmt.Sprint(res)
+}
+
+// Format implements the fmt.Formatter interface.
+//
+// The %v verb formats res as Ok(v) or Err(err), e.g. Ok(42) or Err(failed).
+// Other verbs, flags and precision are applied to the underlying value or error,
+// while width and the - flag are applied to the whole text.
+// Notably, %+v includes the fields and stack trace of a wrapped error (see Wrap and WithStack).
+// The %#v verb formats res as Go syntax (see GoString).
+// Use Bare to format the underlying value or error alone.
+func (res Result[T]) Format(s fmt.State, verb rune) {
+	if format.GoSyntax(s, verb) {
+		io.WriteString(s, res.GoString())
+		return
+	}
+	w := format.NewWriter(s, verb)
+	if res.err != nil {
+		w.WriteString("Err(")
+	} else {
+		w.WriteString("Ok(")
+	}
+	w.Inner(res.inner())
+	w.WriteString(")")
+	w.Flush()
+}
+
+// Bare returns a fmt.Formatter that formats the underlying value or error alone, e.g. 42 or failed.
+func (res Result[T]) Bare() fmt.Formatter {
+	return format.Bare{V: res.inner(), Ok: true}
+}
+
+// inner returns the underlying value or error of res.
+func (res Result[T]) inner() any {
+	switch {
+	case res.err == errNil:
+		return nil
+	case res.err != nil:
+		return res.err
+	}
+	return res.v
+}
+
+// GoString implements the fmt.GoStringer interface.
+// Returns the constructor call that creates res, e.g. result.OfOk[int](42).
+// An error is represented by a call to errors.New with its message.
+func (res Result[T]) GoString() string {
+	switch err := res.Error(); {
+	case err != nil:
+		return fmt.Sprintf("result.OfError[%s](errors.New(%q))", format.TypeName[T](), err.Error())
+	case res.err != nil:
+		return fmt.Sprintf("result.OfError[%s](nil)", format.TypeName[T]())
+	}
+	return fmt.Sprintf("result.OfOk[%s](%#v)", format.TypeName[T](), res.v)
 }
 
 // Unpack returns the underlying value and error.
@@ -145,6 +200,91 @@ func (res Result[T]) OfError() Result[T] {
 	return OfError[T](res.err)
 }
 
+// Or returns the underlying value if ok, or def if not ok.
+func (res Result[T]) Or(def T) T {
+	return res.Value().Or(def)
+}
+
+// OrZero returns the underlying value if ok, or the zero value if not ok.
+func (res Result[T]) OrZero() T {
+	return res.Value().OrZero()
+}
+
+// OrElse returns the underlying value if ok, or the result of f on the underlying error if not ok.
+// The error is nil if res was created by OfError(nil).
+func (res Result[T]) OrElse(f func(error) T) T {
+	if res.err != nil {
+		return f(res.Error())
+	}
+	return res.v
+}
+
+// Recover returns the result of f on the underlying error if not ok.
+// The error is nil if res was created by OfError(nil).
+// Returns res if ok.
+func (res Result[T]) Recover(f func(error) Result[T]) Result[T] {
+	if res.err != nil {
+		return f(res.Error())
+	}
+	return res
+}
+
+// MapError returns a Result where the contained error has been replaced by the result of f.
+// Does nothing if res does not contain an error.
+// Like OfError, the Result contains neither a value nor an error if f returns nil.
+func (res Result[T]) MapError(f func(error) error) Result[T] {
+	if res.IsError() {
+		return OfError[T](f(res.err))
+	}
+	return res
+}
+
+// Do calls f with the underlying value if ok.
+// Does nothing if not ok.
+func (res Result[T]) Do(f func(T)) Result[T] {
+	if res.err == nil {
+		f(res.v)
+	}
+	return res
+}
+
+// DoError calls f with the underlying error if res contains an error.
+// Does nothing otherwise.
+func (res Result[T]) DoError(f func(error)) Result[T] {
+	if res.IsError() {
+		f(res.err)
+	}
+	return res
+}
+
+// Map returns a Result of the result of f on the underlying value.
+// Returns a Result of the underlying error if res is not ok.
+func Map[T, T2 any](res Result[T], f func(T) T2) Result[T2] {
+	if res.err != nil {
+		return Result[T2]{err: res.err}
+	}
+	return OfOk(f(res.v))
+}
+
+// FlatMap returns the result of f on the underlying value.
+// Returns a Result of the underlying error if res is not ok.
+func FlatMap[T, T2 any](res Result[T], f func(T) Result[T2]) Result[T2] {
+	if res.err != nil {
+		return Result[T2]{err: res.err}
+	}
+	return f(res.v)
+}
+
+// AndThen returns a Result of the return values of f on the underlying value.
+// Returns a Result of the underlying error if res is not ok.
+// This aids interoperability with functions that return a value and an error.
+func AndThen[T, T2 any](res Result[T], f func(T) (T2, error)) Result[T2] {
+	if res.err != nil {
+		return Result[T2]{err: res.err}
+	}
+	return Of(f(res.v))
+}
+
 // Transpose converts res to an optional.Value of Result.
 // Returns a not-ok optional.Value if the underlying optional.Value is not ok.
 // Otherwise, returns an ok optional.Value of a Result that contains the underlying value or error.
@@ -222,11 +222,11 @@ func TestResult_IsError(t *testing.T) {
 }
 
 func TestResult_String(t *testing.T) {
-	if got := result.OfOk(1.5).String(); got != "{1.5 <nil>}" {
-		t.Errorf("String() = %v, want %v", got, "1.5")
+	if got := result.OfOk(1.5).String(); got != "Ok(1.5)" {
+		t.Errorf("String() = %v, want %v", got, "Ok(1.5)")
 	}
-	if got := result.OfError[float64](errFail).String(); got != "{0 fail}" {
-		t.Errorf("String() = %v, want %v", got, "{0 fail}")
+	if got := result.OfError[float64](errFail).String(); got != "Err(fail)" {
+		t.Errorf("String() = %v, want %v", got, "Err(fail)")
 	}
 }
 
@@ -289,3 +289,217 @@ func TestResult_Unpack(t *testing.T) {
 		t.Errorf("Unpack() = %v %v, want %v %v", v, err, "foo", nil)
 	}
 }
+
+func TestResult_Or(t *testing.T) {
+	tests := []struct {
+		name string
+		res  result.Result[int]
+		want int
+	}{
+		{name: "ok", res: result.OfOk(1), want: 1},
+		{name: "error", res: result.OfError[int](errFail), want: -1},
+		{name: "nil error", res: result.OfError[int](nil), want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.res.Or(-1); got != tt.want {
+				t.Errorf("Or() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_OrZero(t *testing.T) {
+	if got := result.OfOk("foo").OrZero(); got != "foo" {
+		t.Errorf("OrZero() = %v, want %v", got, "foo")
+	}
+	if got := result.OfError[string](errFail).OrZero(); got != "" {
+		t.Errorf("OrZero() = %v, want %v", got, "")
+	}
+}
+
+func TestResult_OrElse(t *testing.T) {
+	errLen := func(err error) int {
+		if err == nil {
+			return -1
+		}
+		return len(err.Error())
+	}
+	tests := []struct {
+		name string
+		res  result.Result[int]
+		want int
+	}{
+		{name: "ok", res: result.OfOk(1), want: 1},
+		{name: "error", res: result.OfError[int](errFail), want: 4},
+		{name: "nil error", res: result.OfError[int](nil), want: -1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.res.OrElse(errLen); got != tt.want {
+				t.Errorf("OrElse() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Recover(t *testing.T) {
+	recoverFail := func(err error) result.Result[int] {
+		if errors.Is(err, errFail) {
+			return result.OfOk(0)
+		}
+		return result.OfError[int](fmt.Errorf("unrecoverable: %v", err))
+	}
+	errOther := errors.New("other")
+	tests := []struct {
+		name string
+		res  result.Result[int]
+		want result.Result[int]
+	}{
+		{name: "ok", res: result.OfOk(1), want: result.OfOk(1)},
+		{name: "recoverable", res: result.OfError[int](errFail), want: result.OfOk(0)},
+		{name: "unrecoverable", res: result.OfError[int](errOther), want: result.OfError[int](fmt.Errorf("unrecoverable: %v", errOther))},
+		{name: "nil error", res: result.OfError[int](nil), want: result.OfError[int](fmt.Errorf("unrecoverable: %v", nil))},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.res.Recover(recoverFail); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Recover() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_MapError(t *testing.T) {
+	wrap := func(err error) error {
+		return fmt.Errorf("wrapped: %w", err)
+	}
+	tests := []struct {
+		name string
+		res  result.Result[int]
+		f    func(error) error
+		want result.Result[int]
+	}{
+		{name: "ok", res: result.OfOk(1), f: wrap, want: result.OfOk(1)},
+		{name: "error", res: result.OfError[int](errFail), f: wrap, want: result.OfError[int](wrap(errFail))},
+		{name: "nil error", res: result.OfError[int](nil), f: wrap, want: result.OfError[int](nil)},
+		{name: "to nil", res: result.OfError[int](errFail), f: func(error) error { return nil }, want: result.OfError[int](nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.res.MapError(tt.f); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MapError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestResult_Do(t *testing.T) {
+	tests := []struct {
+		name      string
+		res       result.Result[int]
+		wantCalls int
+	}{
+		{name: "ok", res: result.OfOk(1), wantCalls: 1},
+		{name: "error", res: result.OfError[int](errFail), wantCalls: 0},
+		{name: "nil error", res: result.OfError[int](nil), wantCalls: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n int
+			if got := tt.res.Do(func(int) { n++ }); got != tt.res {
+				t.Errorf("Do() = %v, want %v", got, tt.res)
+			}
+			if n != tt.wantCalls {
+				t.Errorf("n after Do() = %v, want %v", n, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestResult_DoError(t *testing.T) {
+	tests := []struct {
+		name      string
+		res       result.Result[int]
+		wantCalls int
+	}{
+		{name: "ok", res: result.OfOk(1), wantCalls: 0},
+		{name: "error", res: result.OfError[int](errFail), wantCalls: 1},
+		{name: "nil error", res: result.OfError[int](nil), wantCalls: 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var n int
+			if got := tt.res.DoError(func(error) { n++ }); got != tt.res {
+				t.Errorf("DoError() = %v, want %v", got, tt.res)
+			}
+			if n != tt.wantCalls {
+				t.Errorf("n after DoError() = %v, want %v", n, tt.wantCalls)
+			}
+		})
+	}
+}
+
+func TestMap(t *testing.T) {
+	tests := []struct {
+		name string
+		res  result.Result[int]
+		want result.Result[string]
+	}{
+		{name: "ok", res: result.OfOk(1), want: result.OfOk("1")},
+		{name: "error", res: result.OfError[int](errFail), want: result.OfError[string](errFail)},
+		{name: "nil error", res: result.OfError[int](nil), want: result.OfError[string](nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.Map(tt.res, strconv.Itoa); got != tt.want {
+				t.Errorf("Map() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestFlatMap(t *testing.T) {
+	atoi := func(s string) result.Result[int] {
+		return result.Of(strconv.Atoi(s))
+	}
+	_, errSyntax := strconv.Atoi("foo")
+	tests := []struct {
+		name string
+		res  result.Result[string]
+		want result.Result[int]
+	}{
+		{name: "ok", res: result.OfOk("1"), want: result.OfOk(1)},
+		{name: "f error", res: result.OfOk("foo"), want: result.OfError[int](errSyntax)},
+		{name: "error", res: result.OfError[string](errFail), want: result.OfError[int](errFail)},
+		{name: "nil error", res: result.OfError[string](nil), want: result.OfError[int](nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.FlatMap(tt.res, atoi); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("FlatMap() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAndThen(t *testing.T) {
+	_, errSyntax := strconv.Atoi("foo")
+	tests := []struct {
+		name string
+		res  result.Result[string]
+		want result.Result[int]
+	}{
+		{name: "ok", res: result.OfOk("1"), want: result.OfOk(1)},
+		{name: "f error", res: result.OfOk("foo"), want: result.OfError[int](errSyntax)},
+		{name: "error", res: result.OfError[string](errFail), want: result.OfError[int](errFail)},
+		{name: "nil error", res: result.OfError[string](nil), want: result.OfError[int](nil)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := result.AndThen(tt.res, strconv.Atoi); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("AndThen() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
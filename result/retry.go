@@ -0,0 +1,176 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import (
+	"context"
+	"fmt"
+	"math/rand/v2"
+	"slices"
+	"time"
+
+	"github.com/phelmkamp/valor/tuple/unit"
+)
+
+// Backoff returns the delay before the next attempt given the number of attempts so far.
+type Backoff func(attempts int) time.Duration
+
+// ConstantBackoff returns a Backoff that always returns d.
+func ConstantBackoff(d time.Duration) Backoff {
+	return func(int) time.Duration {
+		return d
+	}
+}
+
+// ExponentialBackoff returns a Backoff that returns base after the first attempt
+// and doubles after each subsequent attempt, up to max.
+func ExponentialBackoff(base, max time.Duration) Backoff {
+	return func(attempts int) time.Duration {
+		d := base
+		for i := 1; i < attempts && d < max; i++ {
+			if d > max/2 {
+				// avoid overflow
+				return max
+			}
+			d *= 2
+		}
+		return min(d, max)
+	}
+}
+
+// JitteredBackoff returns a Backoff that returns a random duration
+// between zero and the result of b ("full jitter").
+func JitteredBackoff(b Backoff) Backoff {
+	return func(attempts int) time.Duration {
+		d := b(attempts)
+		if d <= 0 {
+			return 0
+		}
+		return rand.N(d + 1)
+	}
+}
+
+// RetryPolicy determines whether and when Retry calls a function again.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of calls, including the first.
+	// Zero or less means no limit.
+	MaxAttempts int
+	// Backoff returns the delay before the next call.
+	// Nil means no delay.
+	Backoff Backoff
+	// Retryable returns whether an error is transient.
+	// Nil means every error is retryable.
+	Retryable func(error) bool
+	// Sleep waits for d or until ctx is done, returning ctx.Err() in the latter case.
+	// Nil means a real timer. This is useful for substituting a fake clock in tests.
+	Sleep func(ctx context.Context, d time.Duration) error
+}
+
+// RetryIs returns a Retryable predicate that matches errors for which ErrorIs is true for any of targets.
+func RetryIs(targets ...error) func(error) bool {
+	return func(err error) bool {
+		res := OfError[unit.Type](err)
+		for _, target := range targets {
+			if res.ErrorIs(target) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+// RetryAs returns a Retryable predicate that matches errors for which ErrorAs is true for type E.
+func RetryAs[E error]() func(error) bool {
+	return func(err error) bool {
+		var target E
+		return OfError[unit.Type](err).ErrorAs(&target)
+	}
+}
+
+// RetryError is the error returned by Retry when every attempt fails.
+// errors.Is and errors.As (and therefore ErrorIs and ErrorAs) match the error of any attempt
+// as well as the context error, if any.
+type RetryError struct {
+	errs   []error
+	ctxErr error
+}
+
+// Error returns the number of attempts, the last error and the context error, if any.
+func (e *RetryError) Error() string {
+	switch {
+	case len(e.errs) == 0:
+		return fmt.Sprintf("no attempts made: %v", e.ctxErr)
+	case e.ctxErr != nil:
+		return fmt.Sprintf("%d attempt(s) failed: %v; then %v", len(e.errs), e.errs[len(e.errs)-1], e.ctxErr)
+	}
+	return fmt.Sprintf("%d attempt(s) failed: %v", len(e.errs), e.errs[len(e.errs)-1])
+}
+
+// Attempts returns the error of each attempt in order.
+func (e *RetryError) Attempts() []error {
+	return e.errs
+}
+
+// ContextErr returns ctx.Err() if the context was done before the next attempt.
+// Returns nil if retrying stopped for any other reason.
+func (e *RetryError) ContextErr() error {
+	return e.ctxErr
+}
+
+// Unwrap returns the error of each attempt followed by the context error, if any.
+// This enables errors.Is and errors.As to match any of the errors.
+func (e *RetryError) Unwrap() []error {
+	if e.ctxErr == nil {
+		return e.errs
+	}
+	return append(slices.Clip(e.errs), e.ctxErr)
+}
+
+// Retry calls f until it succeeds, returns an error that isn't retryable,
+// the maximum number of attempts is reached, or ctx is done.
+// Returns a Result of the value returned by f, or of a *RetryError of every error if no attempt succeeds.
+func Retry[T any](ctx context.Context, policy RetryPolicy, f func() (T, error)) Result[T] {
+	sleep := policy.Sleep
+	if sleep == nil {
+		sleep = sleepContext
+	}
+	var rerr RetryError
+	for {
+		if rerr.ctxErr = ctx.Err(); rerr.ctxErr != nil {
+			break
+		}
+		v, err := f()
+		if err == nil {
+			return OfOk(v)
+		}
+		rerr.errs = append(rerr.errs, err)
+		if len(rerr.errs) == policy.MaxAttempts || (policy.Retryable != nil && !policy.Retryable(err)) {
+			break
+		}
+		var d time.Duration
+		if policy.Backoff != nil {
+			d = policy.Backoff(len(rerr.errs))
+		}
+		if rerr.ctxErr = sleep(ctx, d); rerr.ctxErr != nil {
+			break
+		}
+	}
+	return OfError[T](&rerr)
+}
+
+// sleepContext waits for d or until ctx is done.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
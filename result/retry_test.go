@@ -0,0 +1,201 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"context"
+	"fmt"
+	"io/fs"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+// fakeClock records sleeps instead of waiting.
+type fakeClock struct {
+	sleeps []time.Duration
+}
+
+func (c *fakeClock) Sleep(ctx context.Context, d time.Duration) error {
+	c.sleeps = append(c.sleeps, d)
+	return ctx.Err()
+}
+
+func ExampleRetry() {
+	var clock fakeClock
+	f, _ := failing(2, errFail)
+	policy := result.RetryPolicy{
+		MaxAttempts: 5,
+		Backoff:     result.ExponentialBackoff(100*time.Millisecond, time.Second),
+		Retryable:   result.RetryIs(errFail),
+		Sleep:       clock.Sleep,
+	}
+	res := result.Retry(context.Background(), policy, f)
+	fmt.Println(res.Value().MustOk(), clock.sleeps)
+	// Output: 3 [100ms 200ms]
+}
+
+func TestRetry(t *testing.T) {
+	tests := []struct {
+		name         string
+		failures     int
+		err          error
+		policy       result.RetryPolicy
+		want         result.Result[int]
+		wantAttempts []error
+		wantSleeps   []time.Duration
+	}{
+		{
+			name:       "ok",
+			failures:   0,
+			policy:     result.RetryPolicy{MaxAttempts: 3},
+			want:       result.OfOk(1),
+			wantSleeps: nil,
+		},
+		{
+			name:       "retried",
+			failures:   2,
+			err:        errFail,
+			policy:     result.RetryPolicy{MaxAttempts: 3, Backoff: result.ConstantBackoff(time.Second)},
+			want:       result.OfOk(3),
+			wantSleeps: []time.Duration{time.Second, time.Second},
+		},
+		{
+			name:         "max attempts",
+			failures:     5,
+			err:          errFail,
+			policy:       result.RetryPolicy{MaxAttempts: 3},
+			wantAttempts: []error{errFail, errFail, errFail},
+			wantSleeps:   []time.Duration{0, 0},
+		},
+		{
+			name:         "unlimited",
+			failures:     5,
+			err:          errFail,
+			policy:       result.RetryPolicy{},
+			want:         result.OfOk(6),
+			wantAttempts: nil,
+			wantSleeps:   []time.Duration{0, 0, 0, 0, 0},
+		},
+		{
+			name:         "not retryable",
+			failures:     5,
+			err:          errOther,
+			policy:       result.RetryPolicy{MaxAttempts: 3, Retryable: result.RetryIs(errFail)},
+			wantAttempts: []error{errOther},
+		},
+		{
+			name:       "retryable as",
+			failures:   2,
+			err:        &fs.PathError{Op: "open", Path: "/foo", Err: fs.ErrNotExist},
+			policy:     result.RetryPolicy{Retryable: result.RetryAs[*fs.PathError]()},
+			want:       result.OfOk(3),
+			wantSleeps: []time.Duration{0, 0},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var clock fakeClock
+			tt.policy.Sleep = clock.Sleep
+			f, _ := failing(tt.failures, tt.err)
+			got := result.Retry(context.Background(), tt.policy, f)
+			if tt.wantAttempts == nil && got != tt.want {
+				t.Errorf("Retry() = %v, want %v", got, tt.want)
+			}
+			if tt.wantAttempts != nil {
+				var rerr *result.RetryError
+				if !got.ErrorAs(&rerr) {
+					t.Fatalf("Retry() = %v, want *RetryError", got)
+				}
+				if !reflect.DeepEqual(rerr.Attempts(), tt.wantAttempts) {
+					t.Errorf("Attempts() = %v, want %v", rerr.Attempts(), tt.wantAttempts)
+				}
+				if !got.ErrorIs(tt.err) {
+					t.Errorf("ErrorIs() = %v, want %v", false, true)
+				}
+			}
+			if !reflect.DeepEqual(clock.sleeps, tt.wantSleeps) {
+				t.Errorf("sleeps = %v, want %v", clock.sleeps, tt.wantSleeps)
+			}
+		})
+	}
+}
+
+func TestRetry_context(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int
+	f := func() (int, error) {
+		calls++
+		if calls == 2 {
+			cancel()
+		}
+		return 0, errFail
+	}
+	var clock fakeClock
+	got := result.Retry(ctx, result.RetryPolicy{Sleep: clock.Sleep}, f)
+	var rerr *result.RetryError
+	if !got.ErrorAs(&rerr) {
+		t.Fatalf("Retry() = %v, want *RetryError", got)
+	}
+	if want := []error{errFail, errFail}; !reflect.DeepEqual(rerr.Attempts(), want) {
+		t.Errorf("Attempts() = %v, want %v", rerr.Attempts(), want)
+	}
+	if rerr.ContextErr() != context.Canceled {
+		t.Errorf("ContextErr() = %v, want %v", rerr.ContextErr(), context.Canceled)
+	}
+	if !got.ErrorIs(context.Canceled) || !got.ErrorIs(errFail) {
+		t.Errorf("ErrorIs() = %v, want %v", false, true)
+	}
+	if want := "2 attempt(s) failed: fail; then context canceled"; got.Error().Error() != want {
+		t.Errorf("Error() = %v, want %v", got.Error(), want)
+	}
+
+	// done before first attempt
+	calls = 0
+	got = result.Retry(ctx, result.RetryPolicy{Sleep: clock.Sleep}, f)
+	if !got.ErrorAs(&rerr) || len(rerr.Attempts()) != 0 || calls != 0 {
+		t.Fatalf("Retry() = %v, want *RetryError without attempts", got)
+	}
+	if want := "no attempts made: context canceled"; got.Error().Error() != want {
+		t.Errorf("Error() = %v, want %v", got.Error(), want)
+	}
+
+	// real timer
+	ctx, cancel = context.WithTimeout(context.Background(), time.Millisecond)
+	defer cancel()
+	got = result.Retry(ctx, result.RetryPolicy{Backoff: result.ConstantBackoff(time.Hour)}, f)
+	if !got.ErrorIs(context.DeadlineExceeded) {
+		t.Errorf("Retry() = %v, want %v", got, context.DeadlineExceeded)
+	}
+}
+
+func TestExponentialBackoff(t *testing.T) {
+	b := result.ExponentialBackoff(time.Second, 10*time.Second)
+	var got []time.Duration
+	for i := 1; i <= 6; i++ {
+		got = append(got, b(i))
+	}
+	want := []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 8 * time.Second, 10 * time.Second, 10 * time.Second}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ExponentialBackoff() = %v, want %v", got, want)
+	}
+	if got := result.ExponentialBackoff(time.Second, 1<<62)(100); got != 1<<62 {
+		t.Errorf("ExponentialBackoff() = %v, want %v", got, time.Duration(1<<62))
+	}
+}
+
+func TestJitteredBackoff(t *testing.T) {
+	b := result.JitteredBackoff(result.ConstantBackoff(time.Second))
+	for i := 1; i <= 100; i++ {
+		if got := b(i); got < 0 || got > time.Second {
+			t.Errorf("JitteredBackoff() = %v, want between 0 and %v", got, time.Second)
+		}
+	}
+	if got := result.JitteredBackoff(result.ConstantBackoff(0))(1); got != 0 {
+		t.Errorf("JitteredBackoff() = %v, want %v", got, 0)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result
+
+import "iter"
+
+// Seq returns a sequence that yields the underlying value if ok.
+// The sequence is empty if not ok.
+func (res Result[T]) Seq() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if res.err == nil {
+			yield(res.v)
+		}
+	}
+}
+
+// Values returns a sequence of the underlying values of the Results in seq that are ok.
+// Errors are discarded.
+func Values[T any](seq iter.Seq[Result[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for res := range seq {
+			if res.err == nil && !yield(res.v) {
+				return
+			}
+		}
+	}
+}
+
+// FilterMap returns a sequence of the underlying values of the results of f
+// on the elements of seq that are ok.
+// Errors are discarded.
+func FilterMap[T, T2 any](seq iter.Seq[T], f func(T) Result[T2]) iter.Seq[T2] {
+	return func(yield func(T2) bool) {
+		for v := range seq {
+			if res := f(v); res.err == nil && !yield(res.v) {
+				return
+			}
+		}
+	}
+}
+
+// FirstOk returns the first Result in seq that is ok.
+// Returns a Result of every error joined as a *MultiError if none of them are ok.
+func FirstOk[T any](seq iter.Seq[Result[T]]) Result[T] {
+	var errs []error
+	for res := range seq {
+		if res.err == nil {
+			return res
+		}
+		errs = append(errs, res.Error())
+	}
+	return OfError[T](joinErrors(errs...))
+}
+
+// FirstError returns the error of the first Result in seq that contains an error.
+// Returns nil if none of them contain an error.
+func FirstError[T any](seq iter.Seq[Result[T]]) error {
+	for res := range seq {
+		if res.IsError() {
+			return res.err
+		}
+	}
+	return nil
+}
+
+// TakeWhileOk returns a sequence of the underlying values of the Results in seq
+// up to, but not including, the first Result that is not ok.
+func TakeWhileOk[T any](seq iter.Seq[Result[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for res := range seq {
+			if res.err != nil || !yield(res.v) {
+				return
+			}
+		}
+	}
+}
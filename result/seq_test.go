@@ -0,0 +1,96 @@
+// Copyright 2022 phelmkamp. All rights reserved.
+// Use of this source code is governed by a MIT
+// license that can be found in the LICENSE file.
+
+package result_test
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/phelmkamp/valor/result"
+)
+
+func ExampleTakeWhileOk() {
+	seq := func(yield func(result.Result[int]) bool) {
+		for _, s := range []string{"1", "2", "foo", "4"} {
+			if !yield(result.Of(strconv.Atoi(s))) {
+				return
+			}
+		}
+	}
+	for i := range result.TakeWhileOk(seq) {
+		fmt.Println(i)
+	}
+	fmt.Println(result.FirstError(seq))
+	// Output:
+	// 1
+	// 2
+	// strconv.Atoi: parsing "foo": invalid syntax
+}
+
+var seqResults = []result.Result[int]{
+	result.OfOk(1),
+	result.OfOk(2),
+	result.OfError[int](errFail),
+	result.OfError[int](nil),
+	result.OfOk(5),
+}
+
+func TestResult_Seq(t *testing.T) {
+	if got := slices.Collect(result.OfError[int](errFail).Seq()); got != nil {
+		t.Errorf("Seq() = %v, want %v", got, nil)
+	}
+	if got := slices.Collect(result.OfError[int](nil).Seq()); got != nil {
+		t.Errorf("Seq() = %v, want %v", got, nil)
+	}
+	if got, want := slices.Collect(result.OfOk(1).Seq()), []int{1}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Seq() = %v, want %v", got, want)
+	}
+}
+
+func TestValues(t *testing.T) {
+	if got, want := slices.Collect(result.Values(slices.Values(seqResults))), []int{1, 2, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Values() = %v, want %v", got, want)
+	}
+}
+
+func TestFilterMap(t *testing.T) {
+	atoi := func(s string) result.Result[int] {
+		return result.Of(strconv.Atoi(s))
+	}
+	if got, want := slices.Collect(result.FilterMap(slices.Values([]string{"1", "foo", "3"}), atoi)), []int{1, 3}; !reflect.DeepEqual(got, want) {
+		t.Errorf("FilterMap() = %v, want %v", got, want)
+	}
+}
+
+func TestFirstOk(t *testing.T) {
+	if got := result.FirstOk(slices.Values(seqResults[2:])); got != result.OfOk(5) {
+		t.Errorf("FirstOk() = %v, want %v", got, result.OfOk(5))
+	}
+	got := result.FirstOk(slices.Values([]result.Result[int]{result.OfError[int](errFail), result.OfError[int](errOther)}))
+	if want := []error{errFail, errOther}; !reflect.DeepEqual(got.Errors(), want) {
+		t.Errorf("FirstOk().Errors() = %v, want %v", got.Errors(), want)
+	}
+	if got := result.FirstOk(slices.Values([]result.Result[int]{result.OfError[int](nil)})); got != result.OfError[int](nil) {
+		t.Errorf("FirstOk() = %v, want %v", got, result.OfError[int](nil))
+	}
+}
+
+func TestFirstError(t *testing.T) {
+	if got := result.FirstError(slices.Values(seqResults)); got != errFail {
+		t.Errorf("FirstError() = %v, want %v", got, errFail)
+	}
+	if got := result.FirstError(slices.Values(seqResults[3:])); got != nil {
+		t.Errorf("FirstError() = %v, want %v", got, nil)
+	}
+}
+
+func TestTakeWhileOk(t *testing.T) {
+	if got, want := slices.Collect(result.TakeWhileOk(slices.Values(seqResults))), []int{1, 2}; !reflect.DeepEqual(got, want) {
+		t.Errorf("TakeWhileOk() = %v, want %v", got, want)
+	}
+}
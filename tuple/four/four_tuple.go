@@ -6,6 +6,11 @@
 package four
 
 import (
+	"fmt"
+	"io"
+	"log/slog"
+
+	"github.com/phelmkamp/valor/internal/format"
 	"github.com/phelmkamp/valor/optional"
 	"github.com/phelmkamp/valor/result"
 )
@@ -18,6 +23,9 @@ type Tuple[T, T2, T3, T4 any] struct {
 	V4 T4
 }
 
+// fieldNames are the names of the fields of Tuple in order.
+var fieldNames = [...]string{"V", "V2", "V3", "V4"}
+
 // Values returns the contained values.
 // This aids in assigning to variables or function arguments.
 func (t Tuple[T, T2, T3, T4]) Values() (v T, v2 T2, v3 T3, v4 T4) {
@@ -29,6 +37,52 @@ func TupleOf[T, T2, T3, T4 any](v T, v2 T2, v3 T3, v4 T4) Tuple[T, T2, T3, T4] {
 	return Tuple[T, T2, T3, T4]{V: v, V2: v2, V3: v3, V4: v4}
 }
 
+// Format implements the fmt.Formatter interface.
+//
+// The %v verb formats t as a parenthesized list, e.g. (1, a, true, 2.5).
+// The %+v verb adds field names, e.g. (V:1, V2:a, V3:true, V4:2.5).
+// Other verbs, flags and precision are applied to each value,
+// while width and the - flag are applied to the whole text.
+// The %#v verb formats t as Go syntax (see GoString).
+func (t Tuple[T, T2, T3, T4]) Format(s fmt.State, verb rune) {
+	if format.GoSyntax(s, verb) {
+		io.WriteString(s, t.GoString())
+		return
+	}
+	w := format.NewWriter(s, verb)
+	w.WriteString("(")
+	for i, v := range []any{t.V, t.V2, t.V3, t.V4} {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		if verb == 'v' && s.Flag('+') {
+			w.WriteString(fieldNames[i] + ":")
+		}
+		w.Inner(v)
+	}
+	w.WriteString(")")
+	w.Flush()
+}
+
+// GoString implements the fmt.GoStringer interface.
+// Returns the constructor call that creates t, e.g. four.TupleOf[int, string, bool, float64](1, "a", true, 2.5).
+func (t Tuple[T, T2, T3, T4]) GoString() string {
+	return fmt.Sprintf("four.TupleOf[%s, %s, %s, %s](%#v, %#v, %#v, %#v)",
+		format.TypeName[T](), format.TypeName[T2](), format.TypeName[T3](), format.TypeName[T4](),
+		t.V, t.V2, t.V3, t.V4)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Returns a group of the contained values keyed by field name.
+func (t Tuple[T, T2, T3, T4]) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any(fieldNames[0], t.V),
+		slog.Any(fieldNames[1], t.V2),
+		slog.Any(fieldNames[2], t.V3),
+		slog.Any(fieldNames[3], t.V4),
+	)
+}
+
 // TupleValueOf creates an optional.Value of (v, v2, v3, v4) if ok is true.
 // This aids interoperability with return values
 // that follow the "comma ok" idiom.
@@ -5,7 +5,9 @@
 package four_test
 
 import (
+	"bytes"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"testing"
 	"time"
@@ -21,7 +23,7 @@ func get() (string, int, float32, []int, bool) {
 func Example() {
 	val := four.TupleValueOf(get())
 	fmt.Println(val)
-	// Output: {{a 1 1 [1]} true}
+	// Output: Some((a, 1, 1, [1]))
 }
 
 func TestTuple_Values(t *testing.T) {
@@ -39,3 +41,32 @@ func Test_TupleMap(t *testing.T) {
 		t.Errorf("TupleMap() = %v, want %v", got, four.TupleOf("1", "two", 3.0, time.Time{}))
 	}
 }
+
+func TestTuple_Format(t *testing.T) {
+	tup := four.TupleOf(1, "a", true, 2.5)
+	if got := fmt.Sprintf("%v", tup); got != "(1, a, true, 2.5)" {
+		t.Errorf("Sprintf(%%v) = %v, want %v", got, "(1, a, true, 2.5)")
+	}
+	if got := fmt.Sprintf("%+v", tup); got != "(V:1, V2:a, V3:true, V4:2.5)" {
+		t.Errorf("Sprintf(%%+v) = %v, want %v", got, "(V:1, V2:a, V3:true, V4:2.5)")
+	}
+	if got := fmt.Sprintf("%#v", tup); got != `four.TupleOf[int, string, bool, float64](1, "a", true, 2.5)` {
+		t.Errorf("Sprintf(%%#v) = %v, want %v", got, `four.TupleOf[int, string, bool, float64](1, "a", true, 2.5)`)
+	}
+}
+
+func TestTuple_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("msg", "t", four.TupleOf(1, "a", true, 2.5))
+	if got, want := buf.String(), "level=INFO msg=msg t.V=1 t.V2=a t.V3=true t.V4=2.5\n"; got != want {
+		t.Errorf("log output = %q, want %q", got, want)
+	}
+}
@@ -5,7 +5,9 @@
 package three_test
 
 import (
+	"bytes"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"testing"
 
@@ -20,7 +22,7 @@ func get() (string, int, float32, bool) {
 func Example() {
 	val := three.TupleValueOf(get())
 	fmt.Println(val)
-	// Output: {{a 1 1} true}
+	// Output: Some((a, 1, 1))
 }
 
 func TestTuple_Values(t *testing.T) {
@@ -38,3 +40,32 @@ func Test_TupleMap(t *testing.T) {
 		t.Errorf("TupleMap() = %v, want %v", got, three.TupleOf("1", "two", 3.0))
 	}
 }
+
+func TestTuple_Format(t *testing.T) {
+	tup := three.TupleOf(1, "a", true)
+	if got := fmt.Sprintf("%v", tup); got != "(1, a, true)" {
+		t.Errorf("Sprintf(%%v) = %v, want %v", got, "(1, a, true)")
+	}
+	if got := fmt.Sprintf("%+v", tup); got != "(V:1, V2:a, V3:true)" {
+		t.Errorf("Sprintf(%%+v) = %v, want %v", got, "(V:1, V2:a, V3:true)")
+	}
+	if got := fmt.Sprintf("%#v", tup); got != `three.TupleOf[int, string, bool](1, "a", true)` {
+		t.Errorf("Sprintf(%%#v) = %v, want %v", got, `three.TupleOf[int, string, bool](1, "a", true)`)
+	}
+}
+
+func TestTuple_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("msg", "t", three.TupleOf(1, "a", true))
+	if got, want := buf.String(), "level=INFO msg=msg t.V=1 t.V2=a t.V3=true\n"; got != want {
+		t.Errorf("log output = %q, want %q", got, want)
+	}
+}
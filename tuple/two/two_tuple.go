@@ -6,6 +6,13 @@
 package two
 
 import (
+	"context"
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+
+	"github.com/phelmkamp/valor/internal/format"
 	"github.com/phelmkamp/valor/optional"
 	"github.com/phelmkamp/valor/result"
 	"github.com/phelmkamp/valor/tuple/four"
@@ -17,6 +24,9 @@ type Tuple[T, T2 any] struct {
 	V2 T2
 }
 
+// fieldNames are the names of the fields of Tuple in order.
+var fieldNames = [...]string{"V", "V2"}
+
 // Values returns the contained values.
 // This aids in assigning to variables or function arguments.
 func (t Tuple[T, T2]) Values() (v T, v2 T2) {
@@ -28,6 +38,50 @@ func TupleOf[T, T2 any](v T, v2 T2) Tuple[T, T2] {
 	return Tuple[T, T2]{V: v, V2: v2}
 }
 
+// Format implements the fmt.Formatter interface.
+//
+// The %v verb formats t as a parenthesized list, e.g. (1, a).
+// The %+v verb adds field names, e.g. (V:1, V2:a).
+// Other verbs, flags and precision are applied to each value,
+// while width and the - flag are applied to the whole text.
+// The %#v verb formats t as Go syntax (see GoString).
+func (t Tuple[T, T2]) Format(s fmt.State, verb rune) {
+	if format.GoSyntax(s, verb) {
+		io.WriteString(s, t.GoString())
+		return
+	}
+	w := format.NewWriter(s, verb)
+	w.WriteString("(")
+	for i, v := range []any{t.V, t.V2} {
+		if i > 0 {
+			w.WriteString(", ")
+		}
+		if verb == 'v' && s.Flag('+') {
+			w.WriteString(fieldNames[i] + ":")
+		}
+		w.Inner(v)
+	}
+	w.WriteString(")")
+	w.Flush()
+}
+
+// GoString implements the fmt.GoStringer interface.
+// Returns the constructor call that creates t, e.g. two.TupleOf[int, string](1, "a").
+func (t Tuple[T, T2]) GoString() string {
+	return fmt.Sprintf("two.TupleOf[%s, %s](%#v, %#v)",
+		format.TypeName[T](), format.TypeName[T2](),
+		t.V, t.V2)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// Returns a group of the contained values keyed by field name.
+func (t Tuple[T, T2]) LogValue() slog.Value {
+	return slog.GroupValue(
+		slog.Any(fieldNames[0], t.V),
+		slog.Any(fieldNames[1], t.V2),
+	)
+}
+
 // TupleValueOf creates an optional.Value of (v, v2) if ok is true.
 // This aids interoperability with return values
 // that follow the "comma ok" idiom.
@@ -41,6 +95,34 @@ func TupleResultOf[T, T2 any](v T, v2 T2, err error) result.Result[Tuple[T, T2]]
 	return result.Of(TupleOf(v, v2), err)
 }
 
+// TupleSelect performs a blocking receive on whichever of chs is ready first
+// and creates a Tuple of its index and an optional.Value of the result.
+// The optional.Value is not ok if the channel is closed.
+// A nil channel is never ready.
+func TupleSelect[T any](chs ...<-chan T) Tuple[int, optional.Value[T]] {
+	return TupleSelectContext(context.Background(), chs...)
+}
+
+// TupleSelectContext is like TupleSelect but returns
+// a Tuple of -1 and a not-ok optional.Value if ctx is done first.
+func TupleSelectContext[T any](ctx context.Context, chs ...<-chan T) Tuple[int, optional.Value[T]] {
+	cases := make([]reflect.SelectCase, len(chs)+1)
+	cases[0] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ctx.Done())}
+	for i, ch := range chs {
+		cases[i+1] = reflect.SelectCase{Dir: reflect.SelectRecv, Chan: reflect.ValueOf(ch)}
+	}
+	chosen, recv, ok := reflect.Select(cases)
+	if chosen == 0 {
+		return TupleOf(-1, optional.OfNotOk[T]())
+	}
+	var v T
+	if ok {
+		// comma ok in case T is an interface and the received value is nil
+		v, _ = recv.Interface().(T)
+	}
+	return TupleOf(chosen-1, optional.Of(v, ok))
+}
+
 // TupleMap returns a Tuple with each value replaced by the result of each function.
 //
 // funcs.Ident can be used to leave the value unchanged.
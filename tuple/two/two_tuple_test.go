@@ -5,11 +5,15 @@
 package two_test
 
 import (
+	"bytes"
+	"context"
 	"fmt"
+	"log/slog"
 	"strconv"
 	"testing"
 
 	"github.com/phelmkamp/valor/funcs"
+	"github.com/phelmkamp/valor/optional"
 	"github.com/phelmkamp/valor/tuple/two"
 )
 
@@ -20,7 +24,7 @@ func get() (string, int, bool) {
 func Example() {
 	val := two.TupleValueOf(get())
 	fmt.Println(val)
-	// Output: {{a 1} true}
+	// Output: Some((a, 1))
 }
 
 func TestTuple_Values(t *testing.T) {
@@ -38,3 +42,59 @@ func Test_TupleMap(t *testing.T) {
 		t.Errorf("TupleMap() = %v, want %v", got, two.TupleOf("1", "two"))
 	}
 }
+
+func ExampleTupleSelect() {
+	ch, ch2 := make(chan string), make(chan string, 1)
+	ch2 <- "foo"
+	fmt.Println(two.TupleSelect(ch, ch2))
+	close(ch)
+	fmt.Println(two.TupleSelect[string](ch, nil))
+	// Output:
+	// (1, Some(foo))
+	// (0, None)
+}
+
+func TestTupleSelectContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := make(chan any, 1)
+	ch <- nil
+	if got := two.TupleSelectContext[any](ctx, nil, ch); got != two.TupleOf(1, optional.OfOk[any](nil)) {
+		t.Errorf("TupleSelectContext() = %v, want %v", got, two.TupleOf(1, optional.OfOk[any](nil)))
+	}
+	cancel()
+	if got := two.TupleSelectContext[any](ctx, ch); got != two.TupleOf(-1, optional.OfNotOk[any]()) {
+		t.Errorf("TupleSelectContext() = %v, want %v", got, two.TupleOf(-1, optional.OfNotOk[any]()))
+	}
+}
+
+func TestTuple_Format(t *testing.T) {
+	tup := two.TupleOf(1, "a")
+	if got := fmt.Sprintf("%v", tup); got != "(1, a)" {
+		t.Errorf("Sprintf(%%v) = %v, want %v", got, "(1, a)")
+	}
+	if got := fmt.Sprintf("%+v", tup); got != "(V:1, V2:a)" {
+		t.Errorf("Sprintf(%%+v) = %v, want %v", got, "(V:1, V2:a)")
+	}
+	if got := fmt.Sprintf("%#v", tup); got != `two.TupleOf[int, string](1, "a")` {
+		t.Errorf("Sprintf(%%#v) = %v, want %v", got, `two.TupleOf[int, string](1, "a")`)
+	}
+	if got := fmt.Sprintf("%-8v|", tup); got != "(1, a)  |" {
+		t.Errorf("Sprintf(%%-8v) = %v, want %v", got, "(1, a)  |")
+	}
+}
+
+func TestTuple_LogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(groups []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey && len(groups) == 0 {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+	logger.Info("msg", "t", two.TupleOf(1, "a"))
+	if got, want := buf.String(), "level=INFO msg=msg t.V=1 t.V2=a\n"; got != want {
+		t.Errorf("log output = %q, want %q", got, want)
+	}
+}
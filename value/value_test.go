@@ -34,7 +34,7 @@ func Example() {
 	fmt.Println(val.Ok(&foo2), foo2) // true 42
 
 	val2 := value.Map(val, strconv.Itoa)
-	fmt.Println(val2) // {42 true}
+	fmt.Println(val2) // Some(42)
 
 	bar, ok := m["bar"]
 	val3 := value.Of(bar, ok)
@@ -53,7 +53,7 @@ func Example() {
 	}
 	// Output: true
 	// true 42
-	// {42 true}
+	// Some(42)
 	// -1
 	// 0
 	// 1
@@ -177,7 +177,7 @@ func ExampleUnzipWith_tuple() {
 	v2, v4 := value.UnzipWith(v24, singleton.SetUnzip[string, []int])
 	fmt.Println(v1.MustOk(), v3.MustOk(), v2.MustOk(), v4.MustOk())
 	// Output:
-	// {1 3} {two [4]}
+	// (1, 3) (two, [4])
 	// {1} {3} {two} {[4]}
 }
 
@@ -303,8 +303,8 @@ func ExampleZipWith_tuple() {
 	v1324 := value.ZipWith(v12, v34, two.TupleZip[int, float64, string, []int])
 	fmt.Println(v1324.MustOk())
 	// Output:
-	// {1 two} {3 [4]}
-	// {1 3 two [4]}
+	// (1, two) (3, [4])
+	// (1, 3, two, [4])
 }
 
 func TestZipWith(t *testing.T) {